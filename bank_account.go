@@ -0,0 +1,130 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+const (
+	BankAccountBasePath = "v2/bank-accounts"
+
+	bankAccountByV1IdPath = "by-v1-id"
+)
+
+// Bank account statuses.
+const (
+	BankAccountStatusVerificationInProgress = "VERIFICATION_IN_PROGRESS"
+	BankAccountStatusVerified               = "VERIFIED"
+	BankAccountStatusDisabled               = "DISABLED"
+)
+
+// BankAccountService is an interface for interfacing with the Square Bank Accounts API.
+type BankAccountService interface {
+	ListBankAccounts(ctx context.Context, options *ListOptions) (*ListBankAccounts, *Response, error)
+	GetBankAccount(ctx context.Context, bankAccountId string) (*BankAccount, *Response, error)
+	GetBankAccountByV1Id(ctx context.Context, v1BankAccountId string) (*BankAccount, *Response, error)
+}
+
+var _ BankAccountService = &BankAccountServiceOp{}
+
+// BankAccountServiceOp handles communication with the bank account related methods of the Square API.
+type BankAccountServiceOp struct {
+	client *Client
+}
+
+// ListBankAccounts represents a list of bank accounts.
+type ListBankAccounts struct {
+	BankAccounts []BankAccountEntry `json:"bank_accounts"`
+	Cursor       string             `json:"cursor,omitempty"`
+}
+
+// BankAccount represents a bank account.
+type BankAccount struct {
+	BankAccount *BankAccountEntry `json:"bank_account"`
+}
+
+// BankAccountEntry represents a bank account of a merchant, which payouts are sent to.
+type BankAccountEntry struct {
+	Id                                string `json:"id"`
+	AccountNumberSuffix               string `json:"account_number_suffix"`
+	Country                           string `json:"country"`
+	Currency                          string `json:"currency"`
+	AccountType                       string `json:"account_type"`
+	HolderName                        string `json:"holder_name"`
+	PrimaryBankIdentificationNumber   string `json:"primary_bank_identification_number"`
+	SecondaryBankIdentificationNumber string `json:"secondary_bank_identification_number,omitempty"`
+	DebitMandateReferenceId           string `json:"debit_mandate_reference_id,omitempty"`
+	ReferenceId                       string `json:"reference_id,omitempty"`
+	LocationId                        string `json:"location_id,omitempty"`
+	Status                            string `json:"status"`
+	Creditable                        bool   `json:"creditable"`
+	Debitable                         bool   `json:"debitable"`
+	Fingerprint                       string `json:"fingerprint,omitempty"`
+	Version                           int    `json:"version,omitempty"`
+	BankName                          string `json:"bank_name,omitempty"`
+}
+
+// ListBankAccounts returns a list of the bank accounts of the merchant, optionally for a single location.
+func (s *BankAccountServiceOp) ListBankAccounts(ctx context.Context, options *ListOptions) (*ListBankAccounts, *Response, error) {
+	p, err := addOptions(BankAccountBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListBankAccounts)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetBankAccount returns a bank account by ID.
+func (s *BankAccountServiceOp) GetBankAccount(ctx context.Context, bankAccountId string) (*BankAccount, *Response, error) {
+	if len(bankAccountId) == 0 {
+		return nil, nil, NewArgError("bankAccountId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeBankAccount, bankAccountId)
+	p := path.Join(BankAccountBasePath, bankAccountId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BankAccount)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetBankAccountByV1Id returns a bank account by the ID it had in the Connect V1 API.
+func (s *BankAccountServiceOp) GetBankAccountByV1Id(ctx context.Context, v1BankAccountId string) (*BankAccount, *Response, error) {
+	if len(v1BankAccountId) == 0 {
+		return nil, nil, NewArgError("v1BankAccountId", "cannot be an empty string")
+	}
+
+	p := path.Join(BankAccountBasePath, bankAccountByV1IdPath, v1BankAccountId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BankAccount)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,124 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var bankAccountResponse = `
+{
+  "bank_account": {
+    "id": "ao6iaQ9vhDiaQD7n3GB",
+    "account_number_suffix": "971",
+    "country": "US",
+    "currency": "USD",
+    "account_type": "CHECKING",
+    "holder_name": "Jane Doe",
+    "primary_bank_identification_number": "112200303",
+    "location_id": "S8GWD5R9QB376",
+    "status": "VERIFICATION_IN_PROGRESS",
+    "creditable": false,
+    "debitable": false,
+    "version": 5,
+    "bank_name": "Bank Name"
+  }
+}`
+
+func expectedBankAccount() *BankAccount {
+	return &BankAccount{BankAccount: &BankAccountEntry{
+		Id:                              "ao6iaQ9vhDiaQD7n3GB",
+		AccountNumberSuffix:             "971",
+		Country:                         "US",
+		Currency:                        "USD",
+		AccountType:                     "CHECKING",
+		HolderName:                      "Jane Doe",
+		PrimaryBankIdentificationNumber: "112200303",
+		LocationId:                      "S8GWD5R9QB376",
+		Status:                          BankAccountStatusVerificationInProgress,
+		Version:                         5,
+		BankName:                        "Bank Name",
+	}}
+}
+
+func TestBankAccountServiceOp_ListBankAccounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/bank-accounts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "cursor-1", "location_id": "S8GWD5R9QB376"})
+		fmt.Fprint(w, `{"bank_accounts":[{"id":"ao6iaQ9vhDiaQD7n3GB","status":"VERIFIED"}],"cursor":"cursor-2"}`)
+	})
+
+	got, _, err := client.BankAccount.ListBankAccounts(ctx, &ListOptions{Cursor: "cursor-1", LocationID: "S8GWD5R9QB376"})
+	if err != nil {
+		t.Fatalf("BankAccount.ListBankAccounts returned error: %v", err)
+	}
+
+	expected := &ListBankAccounts{
+		BankAccounts: []BankAccountEntry{{Id: "ao6iaQ9vhDiaQD7n3GB", Status: BankAccountStatusVerified}},
+		Cursor:       "cursor-2",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("BankAccount.ListBankAccounts returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestBankAccountServiceOp_GetBankAccount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "DM7VKY8Q63GNP"}
+
+	var requests int
+	mux.HandleFunc("/v2/bank-accounts/ao6iaQ9vhDiaQD7n3GB", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		fmt.Fprint(w, bankAccountResponse)
+	})
+
+	for i := 0; i < 2; i++ {
+		got, _, err := client.BankAccount.GetBankAccount(ctx, "ao6iaQ9vhDiaQD7n3GB")
+		if err != nil {
+			t.Fatalf("BankAccount.GetBankAccount returned error: %v", err)
+		}
+		if expected := expectedBankAccount(); !reflect.DeepEqual(got, expected) {
+			t.Errorf("BankAccount.GetBankAccount returned %+v, expected %+v", got, expected)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("BankAccount.GetBankAccount made %d requests, expected 1", requests)
+	}
+
+	if _, _, err := client.BankAccount.GetBankAccount(ctx, ""); err == nil {
+		t.Error("BankAccount.GetBankAccount with an empty ID returned no error")
+	}
+}
+
+func TestBankAccountServiceOp_GetBankAccountByV1Id(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/bank-accounts/by-v1-id/3bb7d8fbc1bd", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, bankAccountResponse)
+	})
+
+	got, _, err := client.BankAccount.GetBankAccountByV1Id(ctx, "3bb7d8fbc1bd")
+	if err != nil {
+		t.Fatalf("BankAccount.GetBankAccountByV1Id returned error: %v", err)
+	}
+	if expected := expectedBankAccount(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("BankAccount.GetBankAccountByV1Id returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.BankAccount.GetBankAccountByV1Id(ctx, ""); err == nil {
+		t.Error("BankAccount.GetBankAccountByV1Id with an empty ID returned no error")
+	}
+}
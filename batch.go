@@ -0,0 +1,124 @@
+package squareup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultBatchWorkers is the number of concurrent requests of the BatchGet methods when given no worker count.
+const DefaultBatchWorkers = 4
+
+// maxRateLimitRetries is the number of times a BatchGet method retries a rate limited request.
+const maxRateLimitRetries = 3
+
+// rateLimitBackoff is the wait before the first retry of a rate limited request which does not tell how long to
+// wait. It doubles on every retry.
+var rateLimitBackoff = time.Second
+
+// Limiter paces the requests of a Client. It is satisfied by *rate.Limiter of golang.org/x/time/rate.
+type Limiter interface {
+	// Wait blocks until a request may be made, or returns an error if ctx is done first.
+	Wait(ctx context.Context) error
+}
+
+// SetLimiter is a client option for pacing every request made by the client, including those of the BatchGet
+// methods, with l.
+func SetLimiter(l Limiter) ClientOpt {
+	return func(c *Client) error {
+		c.limiter = l
+		return nil
+	}
+}
+
+// BatchResult is the outcome of retrieving one resource of a batch: either its value or the error which
+// prevented retrieving it.
+type BatchResult[T any] struct {
+	Value T
+	Err   error
+}
+
+// batchGet retrieves the resources with the given IDs with get, running at most workers requests at a time. The
+// results are keyed by ID; duplicate IDs are retrieved once. Rate limited requests are retried after the delay
+// asked for by the API.
+func batchGet[T any](ctx context.Context, ids []string, workers int, get func(context.Context, string) (T, *Response, error)) map[string]BatchResult[T] {
+	results := make(map[string]BatchResult[T], len(ids))
+
+	var unique []string
+	for _, id := range ids {
+		if _, ok := results[id]; ok {
+			continue
+		}
+		results[id] = BatchResult[T]{}
+		unique = append(unique, id)
+	}
+
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	if workers > len(unique) {
+		workers = len(unique)
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	queue := make(chan string)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				var r BatchResult[T]
+				if len(id) == 0 {
+					r.Err = NewArgError("id", "cannot be an empty string")
+				} else {
+					r.Value, r.Err = getWithRetry(ctx, id, get)
+				}
+
+				mu.Lock()
+				results[id] = r
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, id := range unique {
+		queue <- id
+	}
+	close(queue)
+	wg.Wait()
+
+	return results
+}
+
+// getWithRetry calls get, retrying while the API answers 429 Too Many Requests.
+func getWithRetry[T any](ctx context.Context, id string, get func(context.Context, string) (T, *Response, error)) (T, error) {
+	backoff := rateLimitBackoff
+	for attempt := 0; ; attempt++ {
+		value, resp, err := get(ctx, id)
+
+		var errorResponse *ErrorResponse
+		if attempt == maxRateLimitRetries || !errors.As(err, &errorResponse) ||
+			errorResponse.Response.StatusCode != http.StatusTooManyRequests {
+			return value, err
+		}
+
+		wait := backoff
+		if resp != nil && resp.Meta != nil && resp.Meta.RetryAfter > 0 {
+			wait = resp.Meta.RetryAfter
+		}
+		backoff *= 2
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return value, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
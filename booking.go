@@ -0,0 +1,439 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	BookingBasePath = "v2/bookings"
+
+	bookingAvailabilitySearchPath        = "availability/search"
+	bookingBusinessBookingProfilePath    = "business-booking-profile"
+	bookingTeamMemberBookingProfilesPath = "team-member-booking-profiles"
+	bookingBulkRetrievePath              = "bulk-retrieve"
+	bookingCancelPath                    = "cancel"
+)
+
+// BookingStatus is the status of a booking.
+type BookingStatus string
+
+const (
+	BookingStatusPending             BookingStatus = "PENDING"
+	BookingStatusCancelledByCustomer BookingStatus = "CANCELLED_BY_CUSTOMER"
+	BookingStatusCancelledBySeller   BookingStatus = "CANCELLED_BY_SELLER"
+	BookingStatusDeclined            BookingStatus = "DECLINED"
+	BookingStatusAccepted            BookingStatus = "ACCEPTED"
+	BookingStatusNoShow              BookingStatus = "NO_SHOW"
+)
+
+// Booking location types.
+const (
+	BookingLocationTypeBusinessLocation = "BUSINESS_LOCATION"
+	BookingLocationTypeCustomerLocation = "CUSTOMER_LOCATION"
+	BookingLocationTypePhone            = "PHONE"
+)
+
+// BookingService is an interface for interfacing with the Square Bookings API.
+type BookingService interface {
+	CreateBooking(ctx context.Context, booking *CreateBooking) (*Booking, *Response, error)
+	RetrieveBooking(ctx context.Context, bookingId string) (*Booking, *Response, error)
+	UpdateBooking(ctx context.Context, bookingId string, booking *UpdateBooking) (*Booking, *Response, error)
+	CancelBooking(ctx context.Context, bookingId string, cancel *CancelBooking) (*Booking, *Response, error)
+	ListBookings(ctx context.Context, options *ListOptions) (*ListBookings, *Response, error)
+	BulkRetrieveBookings(ctx context.Context, bookingIds []string) (*BulkBookings, *Response, error)
+	SearchAvailability(ctx context.Context, search *SearchAvailability) (*ListAvailabilities, *Response, error)
+
+	RetrieveBusinessBookingProfile(ctx context.Context) (*BusinessBookingProfile, *Response, error)
+	ListTeamMemberBookingProfiles(ctx context.Context, options *ListOptions) (*ListTeamMemberBookingProfiles, *Response, error)
+	RetrieveTeamMemberBookingProfile(ctx context.Context, teamMemberId string) (*TeamMemberBookingProfile, *Response, error)
+}
+
+var _ BookingService = &BookingServiceOp{}
+
+// BookingServiceOp handles communication with the booking related methods of the Square API.
+type BookingServiceOp struct {
+	client *Client
+}
+
+// ListBookings represents a list of bookings.
+type ListBookings struct {
+	Bookings []BookingEntry `json:"bookings"`
+	Cursor   string         `json:"cursor,omitempty"`
+}
+
+// Booking represents a booking.
+type Booking struct {
+	Booking *BookingEntry `json:"booking"`
+}
+
+// BookingEntry represents an appointment of a customer with one or more team members at a location.
+type BookingEntry struct {
+	Id                    string               `json:"id,omitempty"`
+	Version               int                  `json:"version,omitempty"`
+	Status                BookingStatus        `json:"status,omitempty"`
+	CreatedAt             *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time           `json:"updated_at,omitempty"`
+	StartAt               *time.Time           `json:"start_at,omitempty"`
+	LocationId            string               `json:"location_id,omitempty"`
+	CustomerId            string               `json:"customer_id,omitempty"`
+	CustomerNote          string               `json:"customer_note,omitempty"`
+	SellerNote            string               `json:"seller_note,omitempty"`
+	AppointmentSegments   []AppointmentSegment `json:"appointment_segments,omitempty"`
+	TransitionTimeMinutes int                  `json:"transition_time_minutes,omitempty"`
+	AllDay                bool                 `json:"all_day,omitempty"`
+	LocationType          string               `json:"location_type,omitempty"`
+	Source                string               `json:"source,omitempty"`
+}
+
+// AppointmentSegment represents a service performed by a team member during a booking.
+type AppointmentSegment struct {
+	DurationMinutes         int      `json:"duration_minutes,omitempty"`
+	ServiceVariationId      string   `json:"service_variation_id,omitempty"`
+	TeamMemberId            string   `json:"team_member_id"`
+	ServiceVariationVersion int64    `json:"service_variation_version,omitempty"`
+	IntermissionMinutes     int      `json:"intermission_minutes,omitempty"`
+	AnyTeamMember           bool     `json:"any_team_member,omitempty"`
+	ResourceIds             []string `json:"resource_ids,omitempty"`
+}
+
+// EndAt returns the time the booking ends, derived from its start and the durations of its segments.
+func (b *BookingEntry) EndAt() time.Time {
+	if b.StartAt == nil {
+		return time.Time{}
+	}
+
+	minutes := 0
+	for _, s := range b.AppointmentSegments {
+		minutes += s.DurationMinutes + s.IntermissionMinutes
+	}
+
+	return b.StartAt.Add(time.Duration(minutes) * time.Minute)
+}
+
+// CreateBooking represents a booking to be created.
+type CreateBooking struct {
+	IdempotencyKey string        `json:"idempotency_key,omitempty"`
+	Booking        *BookingEntry `json:"booking"`
+}
+
+// UpdateBooking represents an update of a booking.
+type UpdateBooking struct {
+	IdempotencyKey string        `json:"idempotency_key,omitempty"`
+	Booking        *BookingEntry `json:"booking"`
+}
+
+// CancelBooking represents the cancellation of a booking. BookingVersion guards against cancelling a booking
+// that was changed in the meantime.
+type CancelBooking struct {
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	BookingVersion int    `json:"booking_version,omitempty"`
+}
+
+// BulkBookings represents the result of a bulk booking retrieval, keyed by booking ID.
+type BulkBookings struct {
+	Bookings map[string]BulkBookingResult `json:"bookings"`
+	Errors   []APIError                   `json:"errors,omitempty"`
+}
+
+// BulkBookingResult represents the result of one booking of a bulk retrieval.
+type BulkBookingResult struct {
+	Booking *BookingEntry `json:"booking,omitempty"`
+	Errors  []APIError    `json:"errors,omitempty"`
+}
+
+// SearchAvailability represents a search for available booking slots.
+type SearchAvailability struct {
+	Query *AvailabilityQuery `json:"query"`
+}
+
+// AvailabilityQuery represents the filter of an availability search.
+type AvailabilityQuery struct {
+	Filter *AvailabilityFilter `json:"filter"`
+}
+
+// AvailabilityFilter represents the constraints of an availability search. StartAtRange is required and may not
+// span more than 32 days.
+type AvailabilityFilter struct {
+	StartAtRange   *TimeRange      `json:"start_at_range"`
+	LocationId     string          `json:"location_id,omitempty"`
+	SegmentFilters []SegmentFilter `json:"segment_filters,omitempty"`
+	BookingId      string          `json:"booking_id,omitempty"`
+}
+
+// SegmentFilter restricts the service variation and team members of a searched appointment segment.
+type SegmentFilter struct {
+	ServiceVariationId string       `json:"service_variation_id"`
+	TeamMemberIdFilter *FilterValue `json:"team_member_id_filter,omitempty"`
+}
+
+// FilterValue represents a set of values to match. An empty filter matches everything.
+type FilterValue struct {
+	All  []string `json:"all,omitempty"`
+	Any  []string `json:"any,omitempty"`
+	None []string `json:"none,omitempty"`
+}
+
+// ListAvailabilities represents the available booking slots found by a search.
+type ListAvailabilities struct {
+	Availabilities []Availability `json:"availabilities"`
+	Errors         []APIError     `json:"errors,omitempty"`
+}
+
+// Availability represents a booking slot which can be booked as is.
+type Availability struct {
+	StartAt             *time.Time           `json:"start_at,omitempty"`
+	LocationId          string               `json:"location_id,omitempty"`
+	AppointmentSegments []AppointmentSegment `json:"appointment_segments,omitempty"`
+}
+
+// BusinessBookingProfile represents the booking profile of a seller.
+type BusinessBookingProfile struct {
+	BusinessBookingProfile *BusinessBookingProfileEntry `json:"business_booking_profile"`
+}
+
+// BusinessBookingProfileEntry represents the booking settings of a seller.
+type BusinessBookingProfileEntry struct {
+	SellerId                    string                       `json:"seller_id,omitempty"`
+	CreatedAt                   *time.Time                   `json:"created_at,omitempty"`
+	BookingEnabled              bool                         `json:"booking_enabled,omitempty"`
+	CustomerTimezoneChoice      string                       `json:"customer_timezone_choice,omitempty"`
+	BookingPolicy               string                       `json:"booking_policy,omitempty"`
+	AllowUserCancel             bool                         `json:"allow_user_cancel,omitempty"`
+	BusinessAppointmentSettings *BusinessAppointmentSettings `json:"business_appointment_settings,omitempty"`
+	SupportSellerLevelWrites    bool                         `json:"support_seller_level_writes,omitempty"`
+}
+
+// BusinessAppointmentSettings represents the appointment settings of a seller.
+type BusinessAppointmentSettings struct {
+	LocationTypes                  []string     `json:"location_types,omitempty"`
+	AlignmentTime                  string       `json:"alignment_time,omitempty"`
+	MinBookingLeadTimeSeconds      int          `json:"min_booking_lead_time_seconds,omitempty"`
+	MaxBookingLeadTimeSeconds      int          `json:"max_booking_lead_time_seconds,omitempty"`
+	AnyTeamMemberBookingEnabled    bool         `json:"any_team_member_booking_enabled,omitempty"`
+	MultipleServiceBookingEnabled  bool         `json:"multiple_service_booking_enabled,omitempty"`
+	MaxAppointmentsPerDayLimitType string       `json:"max_appointments_per_day_limit_type,omitempty"`
+	MaxAppointmentsPerDayLimit     int          `json:"max_appointments_per_day_limit,omitempty"`
+	CancellationWindowSeconds      int          `json:"cancellation_window_seconds,omitempty"`
+	CancellationFeeMoney           *AmountMoney `json:"cancellation_fee_money,omitempty"`
+	CancellationPolicy             string       `json:"cancellation_policy,omitempty"`
+	CancellationPolicyText         string       `json:"cancellation_policy_text,omitempty"`
+	SkipBookingFlowStaffSelection  bool         `json:"skip_booking_flow_staff_selection,omitempty"`
+}
+
+// ListTeamMemberBookingProfiles represents a list of team member booking profiles.
+type ListTeamMemberBookingProfiles struct {
+	TeamMemberBookingProfiles []TeamMemberBookingProfileEntry `json:"team_member_booking_profiles"`
+	Cursor                    string                          `json:"cursor,omitempty"`
+}
+
+// TeamMemberBookingProfile represents a team member booking profile.
+type TeamMemberBookingProfile struct {
+	TeamMemberBookingProfile *TeamMemberBookingProfileEntry `json:"team_member_booking_profile"`
+}
+
+// TeamMemberBookingProfileEntry represents the booking profile of a team member.
+type TeamMemberBookingProfileEntry struct {
+	TeamMemberId    string `json:"team_member_id,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DisplayName     string `json:"display_name,omitempty"`
+	IsBookable      bool   `json:"is_bookable,omitempty"`
+	ProfileImageUrl string `json:"profile_image_url,omitempty"`
+}
+
+// CreateBooking creates a booking.
+func (s *BookingServiceOp) CreateBooking(ctx context.Context, booking *CreateBooking) (*Booking, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, BookingBasePath, booking)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Booking)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveBooking returns a booking by ID.
+func (s *BookingServiceOp) RetrieveBooking(ctx context.Context, bookingId string) (*Booking, *Response, error) {
+	if len(bookingId) == 0 {
+		return nil, nil, NewArgError("bookingId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(BookingBasePath, bookingId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Booking)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateBooking updates a booking.
+func (s *BookingServiceOp) UpdateBooking(ctx context.Context, bookingId string, booking *UpdateBooking) (*Booking, *Response, error) {
+	if len(bookingId) == 0 {
+		return nil, nil, NewArgError("bookingId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(BookingBasePath, bookingId), booking)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Booking)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CancelBooking cancels a booking.
+func (s *BookingServiceOp) CancelBooking(ctx context.Context, bookingId string, cancel *CancelBooking) (*Booking, *Response, error) {
+	if len(bookingId) == 0 {
+		return nil, nil, NewArgError("bookingId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(BookingBasePath, bookingId, bookingCancelPath), cancel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Booking)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListBookings returns a list of bookings, optionally filtered by customer, team member, location and start time.
+func (s *BookingServiceOp) ListBookings(ctx context.Context, options *ListOptions) (*ListBookings, *Response, error) {
+	p, err := addOptions(BookingBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListBookings)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// BulkRetrieveBookings returns several bookings by ID. Each booking succeeds or fails independently.
+func (s *BookingServiceOp) BulkRetrieveBookings(ctx context.Context, bookingIds []string) (*BulkBookings, *Response, error) {
+	if len(bookingIds) == 0 {
+		return nil, nil, NewArgError("bookingIds", "cannot be empty")
+	}
+
+	body := struct {
+		BookingIds []string `json:"booking_ids"`
+	}{BookingIds: bookingIds}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(BookingBasePath, bookingBulkRetrievePath), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BulkBookings)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SearchAvailability searches for booking slots matching the given location, time range and segment filters.
+func (s *BookingServiceOp) SearchAvailability(ctx context.Context, search *SearchAvailability) (*ListAvailabilities, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(BookingBasePath, bookingAvailabilitySearchPath), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListAvailabilities)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveBusinessBookingProfile returns the booking profile of the seller.
+func (s *BookingServiceOp) RetrieveBusinessBookingProfile(ctx context.Context) (*BusinessBookingProfile, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(BookingBasePath, bookingBusinessBookingProfilePath), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BusinessBookingProfile)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListTeamMemberBookingProfiles returns a list of team member booking profiles, optionally only of bookable team
+// members at a location.
+func (s *BookingServiceOp) ListTeamMemberBookingProfiles(ctx context.Context, options *ListOptions) (*ListTeamMemberBookingProfiles, *Response, error) {
+	p, err := addOptions(path.Join(BookingBasePath, bookingTeamMemberBookingProfilesPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListTeamMemberBookingProfiles)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveTeamMemberBookingProfile returns the booking profile of a team member.
+func (s *BookingServiceOp) RetrieveTeamMemberBookingProfile(ctx context.Context, teamMemberId string) (*TeamMemberBookingProfile, *Response, error) {
+	if len(teamMemberId) == 0 {
+		return nil, nil, NewArgError("teamMemberId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(BookingBasePath, bookingTeamMemberBookingProfilesPath, teamMemberId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(TeamMemberBookingProfile)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,105 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	searchAvailabilityResponse = `
+{
+  "availabilities": [
+    {
+      "start_at": "2024-06-01T13:00:00Z",
+      "location_id": "LY6WNBPVM6VGV",
+      "appointment_segments": [
+        {
+          "duration_minutes": 60,
+          "team_member_id": "TMXUrsBWWcHTt79t",
+          "service_variation_id": "RU3PBTZTK7DXZDQFCJHOK2MC",
+          "service_variation_version": 1599775456731
+        }
+      ]
+    }
+  ]
+}`
+)
+
+func TestBookingServiceOp_SearchAvailability(t *testing.T) {
+	setup()
+	defer teardown()
+
+	search := &SearchAvailability{
+		Query: &AvailabilityQuery{
+			Filter: &AvailabilityFilter{
+				StartAtRange: &TimeRange{StartAt: "2024-06-01T00:00:00Z", EndAt: "2024-06-02T00:00:00Z"},
+				LocationId:   "LY6WNBPVM6VGV",
+				SegmentFilters: []SegmentFilter{
+					{
+						ServiceVariationId: "RU3PBTZTK7DXZDQFCJHOK2MC",
+						TeamMemberIdFilter: &FilterValue{Any: []string{"TMXUrsBWWcHTt79t"}},
+					},
+				},
+			},
+		},
+	}
+
+	mux.HandleFunc("/v2/bookings/availability/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(SearchAvailability)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, search) {
+			t.Errorf("Request body = %+v, expected %+v", v, search)
+		}
+
+		fmt.Fprint(w, searchAvailabilityResponse)
+	})
+
+	got, _, err := client.Booking.SearchAvailability(ctx, search)
+	if err != nil {
+		t.Fatalf("Booking.SearchAvailability returned error: %v", err)
+	}
+
+	startAt := time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)
+	expected := &ListAvailabilities{
+		Availabilities: []Availability{
+			{
+				StartAt:    &startAt,
+				LocationId: "LY6WNBPVM6VGV",
+				AppointmentSegments: []AppointmentSegment{
+					{
+						DurationMinutes:         60,
+						TeamMemberId:            "TMXUrsBWWcHTt79t",
+						ServiceVariationId:      "RU3PBTZTK7DXZDQFCJHOK2MC",
+						ServiceVariationVersion: 1599775456731,
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Booking.SearchAvailability returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestBookingEntry_EndAt(t *testing.T) {
+	startAt := time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)
+	b := &BookingEntry{
+		StartAt: &startAt,
+		AppointmentSegments: []AppointmentSegment{
+			{DurationMinutes: 30, IntermissionMinutes: 15},
+			{DurationMinutes: 45},
+		},
+	}
+
+	if got, expected := b.EndAt(), startAt.Add(90*time.Minute); !got.Equal(expected) {
+		t.Errorf("EndAt() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,332 @@
+package squareup
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Types of the cached resources, as reported in the data of webhook events.
+const (
+	cacheTypeMerchant      = "merchant"
+	cacheTypeTeamMember    = "team_member"
+	cacheTypeBankAccount   = "bank_account"
+	cacheTypeVendor        = "vendor"
+	cacheTypeLocation      = "location"
+	cacheTypeDevice        = "device"
+	cacheTypeCatalogObject = "catalog_object"
+)
+
+// Cache stores the raw JSON responses of read endpoints which opt in to caching: RetrieveMerchant,
+// RetrieveTeamMember, GetBankAccount, RetrieveVendor, RetrieveLocation, GetDevice and RetrieveCatalogObject.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key, if any.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key.
+	Set(key string, value []byte)
+	// Delete removes the value stored under key, if any.
+	Delete(key string)
+}
+
+// SetCache is a client option for caching the responses of read endpoints in cache. Clients may share a cache:
+// their entries are kept apart by merchant and API version. The merchant of a client outside a ClientPool is
+// retrieved with its access token on first use of the cache.
+func SetCache(cache Cache) ClientOpt {
+	return func(c *Client) error {
+		c.cache = cache
+		c.cacheMerchant = new(cacheMerchant)
+		c.cacheVersions = new(cacheVersions)
+		return nil
+	}
+}
+
+// WithoutCache makes a read endpoint fetch the resource from the API rather than from the cache of the client.
+// The fresh response is still cached.
+func WithoutCache() RequestOption {
+	return func(o *requestOptions) {
+		o.skipCache = true
+	}
+}
+
+// InvalidateCache evicts the resource a webhook event reports as created, updated or deleted from the cache of
+// the client, if it is cached, under every API version the client has cached it with. With a cache shared by the
+// clients of a ClientPool, any of them can be used; other clients sharing the cache must be invalidated too.
+func (c *Client) InvalidateCache(e *Event) {
+	if c.cache == nil || e == nil || e.Data == nil || len(e.Data.Id) == 0 {
+		return
+	}
+
+	namespace := e.MerchantID
+	if len(namespace) == 0 {
+		namespace = c.cacheMerchant.cached()
+	}
+	c.evict(namespace, cachedResource{typ: e.Data.Type, id: e.Data.Id})
+}
+
+// evict removes a resource of a merchant from the cache of the client, under every API version the client has
+// cached resources with.
+func (c *Client) evict(namespace string, r cachedResource) {
+	for _, version := range c.cacheVersions.list(c.SquareVersion) {
+		c.cache.Delete(cacheKey(namespace, version, r.typ, r.id))
+	}
+}
+
+// cacheKeyKey is the context key of the cached resource a request reads or changes.
+type cacheKeyKey struct{}
+
+// cachedResource identifies a cached resource by type and ID.
+type cachedResource struct {
+	typ string
+	id  string
+}
+
+// withCache returns a copy of ctx marking the request made with it as reading, or changing, the resource of the
+// given type and ID. GET requests are served from the cache of the client; other requests evict the resource.
+func withCache(ctx context.Context, typ, id string) context.Context {
+	return context.WithValue(ctx, cacheKeyKey{}, cachedResource{typ: typ, id: id})
+}
+
+// cacheKey returns the key of a resource of a merchant, read with the given API version, in the cache.
+func cacheKey(namespace, version, typ, id string) string {
+	return strings.Join([]string{namespace, version, typ, id}, "/")
+}
+
+// cachedRequest is the cached resource a request reads or changes, with the merchant and API version of the
+// request.
+type cachedRequest struct {
+	namespace string
+	version   string
+	resource  cachedResource
+}
+
+// key returns the key the response to the request is cached under.
+func (r cachedRequest) key() string {
+	return cacheKey(r.namespace, r.version, r.resource.typ, r.resource.id)
+}
+
+// cachedRequest returns the cached resource a request made with ctx reads or changes, if it is cacheable.
+// Requests are not cacheable while the merchant of the client cannot be retrieved.
+func (c *Client) cachedRequest(ctx context.Context) (cachedRequest, bool) {
+	if c.cache == nil || c.cacheMerchant == nil {
+		return cachedRequest{}, false
+	}
+	r, ok := ctx.Value(cacheKeyKey{}).(cachedResource)
+	if !ok {
+		return cachedRequest{}, false
+	}
+
+	namespace, err := c.cacheMerchant.resolve(ctx, c)
+	if err != nil {
+		return cachedRequest{}, false
+	}
+
+	version := c.SquareVersion
+	if v := requestOptionsFrom(ctx).version; len(v) > 0 {
+		version = v
+	}
+	c.cacheVersions.add(version)
+
+	return cachedRequest{namespace: namespace, version: version, resource: r}, true
+}
+
+// cacheMerchant is the merchant whose resources a client caches. Clients of a ClientPool are given theirs; other
+// clients retrieve the merchant of their access token.
+type cacheMerchant struct {
+	mu sync.Mutex
+	id string
+}
+
+// cached returns the ID of the merchant, if known.
+func (m *cacheMerchant) cached() string {
+	if m == nil {
+		return ""
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.id
+}
+
+// resolve returns the ID of the merchant, retrieving the merchant of the access token of c if it is not known.
+// The lookup is made without holding the lock, so requests made meanwhile may look the merchant up too, and
+// without the options and cached resource of the request it is made for.
+func (m *cacheMerchant) resolve(ctx context.Context, c *Client) (string, error) {
+	if id := m.cached(); len(id) > 0 {
+		return id, nil
+	}
+
+	merchant, _, err := c.Merchant.CurrentMerchant(withoutRequestOptions(ctx))
+	if err != nil {
+		return "", err
+	}
+	if merchant.Merchant == nil || len(merchant.Merchant.Id) == 0 {
+		return "", fmt.Errorf("squareup: current merchant has no ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.id) == 0 {
+		m.id = merchant.Merchant.Id
+	}
+	return m.id, nil
+}
+
+// cacheVersions is the set of API versions a client, and the other clients of its ClientPool, cached resources
+// with.
+type cacheVersions struct {
+	mu       sync.Mutex
+	versions map[string]bool
+}
+
+// add adds version to the set.
+func (v *cacheVersions) add(version string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.versions == nil {
+		v.versions = make(map[string]bool)
+	}
+	v.versions[version] = true
+}
+
+// list returns the versions of the set, starting with version.
+func (v *cacheVersions) list(version string) []string {
+	versions := []string{version}
+	if v == nil {
+		return versions
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for other := range v.versions {
+		if other != version {
+			versions = append(versions, other)
+		}
+	}
+	return versions
+}
+
+// cachedResponse returns the response to a request served from the cache.
+func cachedResponse(req *http.Request) *Response {
+	return &Response{
+		Response: &http.Response{
+			Status:     http.StatusText(http.StatusOK),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Request:    req,
+		},
+		Meta: &Meta{},
+	}
+}
+
+// LRUCache is an in-memory Cache holding up to a fixed number of entries for a limited time. The least recently
+// used entry is evicted to make room for new ones. It is safe for concurrent use.
+type LRUCache struct {
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+var _ Cache = &LRUCache{}
+
+// lruEntry is an entry of an LRUCache.
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache creates a cache of up to size entries, each kept for ttl. A ttl of zero keeps entries until they
+// are evicted.
+func NewLRUCache(size int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored under key, if any and not expired.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*lruEntry)
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		c.remove(el)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Set stores value under key, evicting the least recently used entry if the cache is full.
+func (c *LRUCache) Set(key string, value []byte) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = c.now().Add(c.ttl)
+	}
+
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.value, e.expiresAt = value, expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+// Delete removes the value stored under key, if any.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones not evicted yet.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// remove removes el from the cache. c.mu must be held.
+func (c *LRUCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry).key)
+}
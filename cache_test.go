@@ -0,0 +1,301 @@
+package squareup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2024, 5, 20, 12, 0, 0, 0, time.UTC)
+	c := NewLRUCache(2, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	c.Get("a")
+	c.Set("c", []byte("3"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("LRUCache kept the least recently used entry")
+	}
+	if v, ok := c.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("LRUCache.Get(%q) = %q, %v, expected %q", "a", v, ok, "1")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("c"); ok {
+		t.Error("LRUCache returned an expired entry")
+	}
+	if c.Len() != 1 {
+		t.Errorf("LRUCache.Len() = %d, expected 1", c.Len())
+	}
+}
+
+func TestClient_cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+
+	var lookups, requests int
+	mux.HandleFunc("/v2/merchants/me", func(w http.ResponseWriter, r *http.Request) {
+		lookups++
+		fmt.Fprint(w, `{"merchant":{"id":"DM7VKY8Q63GNP"}}`)
+	})
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"vendor":{"id":"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4","name":"Vendor %d"}}`, requests)
+	})
+
+	for i := 0; i < 2; i++ {
+		vendor, _, err := client.Vendor.RetrieveVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4")
+		if err != nil {
+			t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+		}
+		if vendor.Vendor.Name != "Vendor 1" {
+			t.Errorf("Vendor.RetrieveVendor name = %q, expected %q", vendor.Vendor.Name, "Vendor 1")
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Vendor.RetrieveVendor made %d requests, expected 1", requests)
+	}
+	if lookups != 1 {
+		t.Errorf("Client looked up its merchant %d times, expected 1", lookups)
+	}
+
+	client.InvalidateCache(&Event{
+		MerchantID: "DM7VKY8Q63GNP",
+		Type:       "vendor.updated",
+		Data:       &EventData{Type: "vendor", Id: "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"},
+	})
+
+	vendor, _, err := client.Vendor.RetrieveVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4")
+	if err != nil {
+		t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+	}
+	if vendor.Vendor.Name != "Vendor 2" {
+		t.Errorf("Vendor.RetrieveVendor after invalidation name = %q, expected %q", vendor.Vendor.Name, "Vendor 2")
+	}
+
+	if _, _, err := client.Vendor.RetrieveVendor(WithRequestOptions(ctx, WithoutCache()), "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"); err != nil {
+		t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Vendor.RetrieveVendor without cache made %d requests in total, expected 3", requests)
+	}
+}
+
+func TestClient_cache_version(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "DM7VKY8Q63GNP"}
+
+	versions := make(map[string]int)
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		versions[r.Header.Get("Square-Version")]++
+		fmt.Fprint(w, `{"vendor":{"id":"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"}}`)
+	})
+
+	retrieve := func(ctx context.Context) {
+		t.Helper()
+		if _, _, err := client.Vendor.RetrieveVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"); err != nil {
+			t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+		}
+	}
+
+	older := WithRequestOptions(ctx, WithVersion("2023-01-19"))
+	for i := 0; i < 2; i++ {
+		retrieve(ctx)
+		retrieve(older)
+	}
+	if versions[client.SquareVersion] != 1 || versions["2023-01-19"] != 1 {
+		t.Errorf("Vendor.RetrieveVendor requests by version = %v, expected one per version", versions)
+	}
+
+	client.InvalidateCache(&Event{
+		MerchantID: "DM7VKY8Q63GNP",
+		Data:       &EventData{Type: "vendor", Id: "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"},
+	})
+	retrieve(ctx)
+	retrieve(older)
+	if versions[client.SquareVersion] != 2 || versions["2023-01-19"] != 2 {
+		t.Errorf("Vendor.RetrieveVendor requests by version after invalidation = %v, expected two per version", versions)
+	}
+}
+
+func TestClient_cache_writeEvictsEveryVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "DM7VKY8Q63GNP"}
+
+	versions := make(map[string]int)
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			versions[r.Header.Get("Square-Version")]++
+		}
+		fmt.Fprint(w, `{"vendor":{"id":"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"}}`)
+	})
+
+	retrieve := func(ctx context.Context) {
+		t.Helper()
+		if _, _, err := client.Vendor.RetrieveVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"); err != nil {
+			t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+		}
+	}
+
+	older := WithRequestOptions(ctx, WithVersion("2023-01-19"))
+	retrieve(ctx)
+	retrieve(older)
+
+	update := &UpdateVendor{Vendor: &VendorEntry{Name: "Joe's Fresh Seafood", Version: 1}}
+	if _, _, err := client.Vendor.UpdateVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", update); err != nil {
+		t.Fatalf("Vendor.UpdateVendor returned error: %v", err)
+	}
+	retrieve(ctx)
+	retrieve(older)
+
+	if versions[client.SquareVersion] != 2 || versions["2023-01-19"] != 2 {
+		t.Errorf("Vendor.RetrieveVendor requests by version after update = %v, expected two per version", versions)
+	}
+}
+
+func TestClient_cache_resolveMerchant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/v2/merchants/me", func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Square-Version"); v != client.SquareVersion {
+			t.Errorf("Merchant lookup Square-Version = %q, expected %q", v, client.SquareVersion)
+		}
+		fmt.Fprint(w, `{"merchant":{"id":"DM7VKY8Q63GNP"}}`)
+	})
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"vendor":{"id":"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"}}`)
+	})
+
+	older := WithRequestOptions(ctx, WithVersion("2023-01-19"))
+	if _, _, err := client.Vendor.RetrieveVendor(older, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"); err != nil {
+		t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+	}
+	if id := client.cacheMerchant.cached(); id != "DM7VKY8Q63GNP" {
+		t.Errorf("Client cached merchant %q, expected %q", id, "DM7VKY8Q63GNP")
+	}
+	if client.cache.(*LRUCache).Len() != 1 {
+		t.Errorf("Vendor.RetrieveVendor cached %d entries, expected 1", client.cache.(*LRUCache).Len())
+	}
+}
+
+func TestClient_cache_currentMerchant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+
+	var me, byId int
+	mux.HandleFunc("/v2/merchants/me", func(w http.ResponseWriter, r *http.Request) {
+		me++
+		fmt.Fprintf(w, `{"merchant":{"id":"DM7VKY8Q63GNP","business_name":"Name %d"}}`, me)
+	})
+	mux.HandleFunc("/v2/merchants/DM7VKY8Q63GNP", func(w http.ResponseWriter, r *http.Request) {
+		byId++
+		fmt.Fprint(w, `{"merchant":{"id":"DM7VKY8Q63GNP"}}`)
+	})
+
+	for i := 1; i <= 2; i++ {
+		merchant, _, err := client.Merchant.CurrentMerchant(ctx)
+		if err != nil {
+			t.Fatalf("Merchant.CurrentMerchant returned error: %v", err)
+		}
+		if expected := fmt.Sprintf("Name %d", i); merchant.Merchant.BusinessName != expected {
+			t.Errorf("Merchant.CurrentMerchant name = %q, expected %q", merchant.Merchant.BusinessName, expected)
+		}
+	}
+	if client.cache.(*LRUCache).Len() != 0 {
+		t.Errorf("Merchant.CurrentMerchant cached %d entries, expected none", client.cache.(*LRUCache).Len())
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Merchant.RetrieveMerchant(ctx, "DM7VKY8Q63GNP"); err != nil {
+			t.Fatalf("Merchant.RetrieveMerchant returned error: %v", err)
+		}
+	}
+	if me != 3 || byId != 1 {
+		t.Errorf("Merchant requests = %d by token and %d by ID, expected 3 and 1", me, byId)
+	}
+}
+
+func TestClient_cache_shared(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tokens := map[string]string{"MERCHANT_A": "token-a", "MERCHANT_B": "token-b"}
+	merchants := map[string]string{"Bearer token-a": "MERCHANT_A", "Bearer token-b": "MERCHANT_B"}
+	mux.HandleFunc("/v2/merchants/me", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"merchant":{"id":%q}}`, merchants[r.Header.Get("Authorization")])
+	})
+
+	requests := make(map[string]int)
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		merchant := merchants[r.Header.Get("Authorization")]
+		requests[merchant]++
+		fmt.Fprintf(w, `{"vendor":{"id":"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4","name":%q}}`, merchant)
+	})
+
+	cache := NewLRUCache(10, time.Hour)
+	clients := make(map[string]*Client)
+	for merchant, token := range tokens {
+		c, err := New(nil, ModeSandbox, SetBaseURL(server.URL), SetCache(cache), SetAuthToken(token))
+		if err != nil {
+			t.Fatalf("New(): %v", err)
+		}
+		clients[merchant] = c
+	}
+
+	retrieve := func(merchant string) {
+		t.Helper()
+		vendor, _, err := clients[merchant].Vendor.RetrieveVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4")
+		if err != nil {
+			t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+		}
+		if vendor.Vendor.Name != merchant {
+			t.Errorf("Vendor.RetrieveVendor of %s name = %q, expected %q", merchant, vendor.Vendor.Name, merchant)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		retrieve("MERCHANT_A")
+		retrieve("MERCHANT_B")
+	}
+	if requests["MERCHANT_A"] != 1 || requests["MERCHANT_B"] != 1 {
+		t.Errorf("Vendor.RetrieveVendor requests by merchant = %v, expected one each", requests)
+	}
+
+	clients["MERCHANT_B"].InvalidateCache(&Event{
+		MerchantID: "MERCHANT_A",
+		Data:       &EventData{Type: "vendor", Id: "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"},
+	})
+	retrieve("MERCHANT_A")
+	retrieve("MERCHANT_B")
+	if requests["MERCHANT_A"] != 2 || requests["MERCHANT_B"] != 1 {
+		t.Errorf("Vendor.RetrieveVendor requests by merchant after invalidation = %v, expected 2 and 1", requests)
+	}
+}
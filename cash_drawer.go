@@ -0,0 +1,264 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	CashDrawerBasePath = "v2/cash-drawers/shifts"
+
+	cashDrawerEventsPath = "events"
+)
+
+// Cash drawer shift states.
+const (
+	CashDrawerShiftStateOpen   = "OPEN"
+	CashDrawerShiftStateEnded  = "ENDED"
+	CashDrawerShiftStateClosed = "CLOSED"
+)
+
+// CashDrawerService is an interface for interfacing with the Square Cash Drawer Shifts API.
+type CashDrawerService interface {
+	ListCashDrawerShifts(ctx context.Context, options *ListOptions) (*ListCashDrawerShifts, *Response, error)
+	RetrieveCashDrawerShift(ctx context.Context, locationId, shiftId string) (*CashDrawerShift, *Response, error)
+	ListCashDrawerShiftEvents(ctx context.Context, shiftId string, options *ListOptions) (*ListCashDrawerShiftEvents, *Response, error)
+}
+
+var _ CashDrawerService = &CashDrawerServiceOp{}
+
+// CashDrawerServiceOp handles communication with the cash drawer related methods of the Square API.
+type CashDrawerServiceOp struct {
+	client *Client
+}
+
+// ListCashDrawerShifts represents a list of cash drawer shift summaries.
+type ListCashDrawerShifts struct {
+	CashDrawerShifts []CashDrawerShiftSummary `json:"cash_drawer_shifts"`
+	Cursor           string                   `json:"cursor,omitempty"`
+}
+
+// CashDrawerShiftSummary represents the totals of a cash drawer shift, as listed.
+type CashDrawerShiftSummary struct {
+	Id                string       `json:"id"`
+	State             string       `json:"state"`
+	OpenedAt          *time.Time   `json:"opened_at,omitempty"`
+	EndedAt           *time.Time   `json:"ended_at,omitempty"`
+	ClosedAt          *time.Time   `json:"closed_at,omitempty"`
+	Description       string       `json:"description,omitempty"`
+	OpenedCashMoney   *AmountMoney `json:"opened_cash_money,omitempty"`
+	ExpectedCashMoney *AmountMoney `json:"expected_cash_money,omitempty"`
+	ClosedCashMoney   *AmountMoney `json:"closed_cash_money,omitempty"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+	LocationId        string       `json:"location_id"`
+}
+
+// CashDrawerShift represents a cash drawer shift.
+type CashDrawerShift struct {
+	CashDrawerShift *CashDrawerShiftEntry `json:"cash_drawer_shift"`
+}
+
+// CashDrawerShiftEntry represents a cash session of a cash drawer, from opening to closing.
+type CashDrawerShiftEntry struct {
+	Id                  string            `json:"id"`
+	State               string            `json:"state"`
+	OpenedAt            *time.Time        `json:"opened_at,omitempty"`
+	EndedAt             *time.Time        `json:"ended_at,omitempty"`
+	ClosedAt            *time.Time        `json:"closed_at,omitempty"`
+	Description         string            `json:"description,omitempty"`
+	OpenedCashMoney     *AmountMoney      `json:"opened_cash_money,omitempty"`
+	CashPaymentMoney    *AmountMoney      `json:"cash_payment_money,omitempty"`
+	CashRefundsMoney    *AmountMoney      `json:"cash_refunds_money,omitempty"`
+	CashPaidInMoney     *AmountMoney      `json:"cash_paid_in_money,omitempty"`
+	CashPaidOutMoney    *AmountMoney      `json:"cash_paid_out_money,omitempty"`
+	ExpectedCashMoney   *AmountMoney      `json:"expected_cash_money,omitempty"`
+	ClosedCashMoney     *AmountMoney      `json:"closed_cash_money,omitempty"`
+	Device              *CashDrawerDevice `json:"device,omitempty"`
+	CreatedAt           time.Time         `json:"created_at"`
+	UpdatedAt           time.Time         `json:"updated_at"`
+	LocationId          string            `json:"location_id"`
+	TeamMemberIds       []string          `json:"team_member_ids,omitempty"`
+	OpeningTeamMemberId string            `json:"opening_team_member_id,omitempty"`
+	EndingTeamMemberId  string            `json:"ending_team_member_id,omitempty"`
+	ClosingTeamMemberId string            `json:"closing_team_member_id,omitempty"`
+}
+
+// CashDrawerDevice represents the device a cash drawer is attached to.
+type CashDrawerDevice struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ListCashDrawerShiftEvents represents a list of cash drawer shift events.
+type ListCashDrawerShiftEvents struct {
+	CashDrawerShiftEvents []CashDrawerShiftEvent `json:"cash_drawer_shift_events"`
+	Cursor                string                 `json:"cursor,omitempty"`
+}
+
+// CashDrawerShiftEvent represents a change of the cash in a drawer, such as a cash payment or a paid out.
+type CashDrawerShiftEvent struct {
+	Id           string       `json:"id"`
+	EventType    string       `json:"event_type"`
+	EventMoney   *AmountMoney `json:"event_money,omitempty"`
+	CreatedAt    time.Time    `json:"created_at"`
+	Description  string       `json:"description,omitempty"`
+	TeamMemberId string       `json:"team_member_id,omitempty"`
+}
+
+// CashDrawerReconciliation represents the cash expected in a drawer from the payments taken during its shift,
+// compared with the cash counted when the shift was closed.
+type CashDrawerReconciliation struct {
+	// ExpectedCashMoney is the opened cash plus the cash kept from payments, less refunds and paid outs.
+	ExpectedCashMoney AmountMoney
+	// ClosedCashMoney is the cash counted when the shift was closed.
+	ClosedCashMoney AmountMoney
+	// DifferenceMoney is the closed cash less the expected cash. It is negative if cash is missing.
+	DifferenceMoney AmountMoney
+	// PaymentIds are the IDs of the cash payments counted.
+	PaymentIds []string
+}
+
+// Reconcile computes the cash expected in the drawer from the CashDetails of payments, and compares it with
+// the closed cash of the shift. Only completed cash payments taken at the location of the shift while it was
+// open are counted, so payments may be listed for a wider period. If the shift reports its Device, payments
+// taken on another device, or without DeviceDetails, are not counted either; otherwise the caller must pass only
+// the payments of this drawer, as a location may run several drawers at once. Refunds, paid ins and paid outs
+// are taken from the shift.
+func (s *CashDrawerShiftEntry) Reconcile(payments []PaymentEntry) *CashDrawerReconciliation {
+	r := &CashDrawerReconciliation{}
+	// Square reports refunds and paid outs as negative amounts.
+	r.ExpectedCashMoney.Amount = amountOf(s.OpenedCashMoney) + amountOf(s.CashRefundsMoney) +
+		amountOf(s.CashPaidInMoney) + amountOf(s.CashPaidOutMoney)
+
+	for i := range payments {
+		p := &payments[i]
+		if p.SourceType != PaymentSourceTypeCash || p.Status != PaymentStatusCompleted || p.CashDetails == nil ||
+			p.LocationId != s.LocationId || !s.covers(p.CreatedAt) || !s.takenOnDevice(p) {
+			continue
+		}
+
+		r.ExpectedCashMoney.Amount += amountOf(p.CashDetails.BuyerSuppliedMoney) - amountOf(p.CashDetails.ChangeBackMoney)
+		r.PaymentIds = append(r.PaymentIds, p.Id)
+	}
+
+	r.ClosedCashMoney.Amount = amountOf(s.ClosedCashMoney)
+	r.DifferenceMoney.Amount = r.ClosedCashMoney.Amount - r.ExpectedCashMoney.Amount
+
+	if s.OpenedCashMoney != nil {
+		r.ExpectedCashMoney.Currency = s.OpenedCashMoney.Currency
+		r.ClosedCashMoney.Currency = s.OpenedCashMoney.Currency
+		r.DifferenceMoney.Currency = s.OpenedCashMoney.Currency
+	}
+
+	return r
+}
+
+// covers reports whether t is within the shift, from its opening until it ended. Shifts which have not ended
+// cover any time after their opening.
+func (s *CashDrawerShiftEntry) covers(t time.Time) bool {
+	if s.OpenedAt != nil && t.Before(*s.OpenedAt) {
+		return false
+	}
+	return s.EndedAt == nil || !t.After(*s.EndedAt)
+}
+
+// takenOnDevice reports whether p was taken on the device of the shift. Any payment matches a shift which does not
+// report its device.
+func (s *CashDrawerShiftEntry) takenOnDevice(p *PaymentEntry) bool {
+	if s.Device == nil || len(s.Device.Id) == 0 {
+		return true
+	}
+	return p.DeviceDetails != nil && p.DeviceDetails.DeviceId == s.Device.Id
+}
+
+// amountOf returns the amount of m, or 0 if m is nil.
+func amountOf(m *AmountMoney) int {
+	if m == nil {
+		return 0
+	}
+	return m.Amount
+}
+
+// ListCashDrawerShifts returns a list of the cash drawer shifts of a location. The location ID is required.
+func (s *CashDrawerServiceOp) ListCashDrawerShifts(ctx context.Context, options *ListOptions) (*ListCashDrawerShifts, *Response, error) {
+	if options == nil || len(options.LocationID) == 0 {
+		return nil, nil, NewArgError("options.LocationID", "cannot be an empty string")
+	}
+
+	p, err := addOptions(CashDrawerBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListCashDrawerShifts)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveCashDrawerShift returns a cash drawer shift of a location by ID.
+func (s *CashDrawerServiceOp) RetrieveCashDrawerShift(ctx context.Context, locationId, shiftId string) (*CashDrawerShift, *Response, error) {
+	if len(locationId) == 0 {
+		return nil, nil, NewArgError("locationId", "cannot be an empty string")
+	}
+	if len(shiftId) == 0 {
+		return nil, nil, NewArgError("shiftId", "cannot be an empty string")
+	}
+
+	p, err := addOptions(path.Join(CashDrawerBasePath, shiftId), &ListOptions{LocationID: locationId})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CashDrawerShift)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListCashDrawerShiftEvents returns a list of the events of a cash drawer shift. The location ID is required.
+func (s *CashDrawerServiceOp) ListCashDrawerShiftEvents(ctx context.Context, shiftId string, options *ListOptions) (*ListCashDrawerShiftEvents, *Response, error) {
+	if len(shiftId) == 0 {
+		return nil, nil, NewArgError("shiftId", "cannot be an empty string")
+	}
+	if options == nil || len(options.LocationID) == 0 {
+		return nil, nil, NewArgError("options.LocationID", "cannot be an empty string")
+	}
+
+	p, err := addOptions(path.Join(CashDrawerBasePath, shiftId, cashDrawerEventsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListCashDrawerShiftEvents)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
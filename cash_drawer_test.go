@@ -0,0 +1,105 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	retrieveCashDrawerShiftResponse = `
+{
+  "cash_drawer_shift": {
+    "id": "DCC99978-09A6-4926-849F-300BE9C5793A",
+    "state": "CLOSED",
+    "opened_at": "2019-11-22T00:42:54.515Z",
+    "ended_at": "2019-11-22T00:44:49.916Z",
+    "closed_at": "2019-11-22T00:44:49.916Z",
+    "description": "Misplaced some change",
+    "opened_cash_money": {"amount": 10000, "currency": "USD"},
+    "cash_payment_money": {"amount": 100, "currency": "USD"},
+    "cash_refunds_money": {"amount": -100, "currency": "USD"},
+    "cash_paid_in_money": {"amount": 10000, "currency": "USD"},
+    "cash_paid_out_money": {"amount": -10000, "currency": "USD"},
+    "expected_cash_money": {"amount": 10000, "currency": "USD"},
+    "closed_cash_money": {"amount": 9970, "currency": "USD"},
+    "created_at": "2019-11-22T00:42:54.515Z",
+    "updated_at": "2019-11-22T00:44:49.916Z",
+    "location_id": "P034NEENMD09F",
+    "device": {"id": "DEVICE_1", "name": "Front counter"}
+  }
+}`
+)
+
+func TestCashDrawerServiceOp_RetrieveCashDrawerShift(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/cash-drawers/shifts/DCC99978-09A6-4926-849F-300BE9C5793A", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"location_id": "P034NEENMD09F"})
+		fmt.Fprint(w, retrieveCashDrawerShiftResponse)
+	})
+
+	got, _, err := client.CashDrawer.RetrieveCashDrawerShift(ctx, "P034NEENMD09F", "DCC99978-09A6-4926-849F-300BE9C5793A")
+	if err != nil {
+		t.Fatalf("CashDrawer.RetrieveCashDrawerShift returned error: %v", err)
+	}
+
+	shift := got.CashDrawerShift
+	openedAt := shift.OpenedAt.Add(time.Minute)
+	payments := []PaymentEntry{
+		{
+			Id:            "cash-in-shift",
+			CreatedAt:     openedAt,
+			Status:        PaymentStatusCompleted,
+			SourceType:    PaymentSourceTypeCash,
+			LocationId:    "P034NEENMD09F",
+			DeviceDetails: &DeviceDetails{DeviceId: "DEVICE_1"},
+			CashDetails:   &CashDetails{BuyerSuppliedMoney: &AmountMoney{Amount: 200, Currency: "USD"}, ChangeBackMoney: &AmountMoney{Amount: 100, Currency: "USD"}},
+		},
+		{
+			Id:            "cash-other-drawer",
+			CreatedAt:     openedAt,
+			Status:        PaymentStatusCompleted,
+			SourceType:    PaymentSourceTypeCash,
+			LocationId:    "P034NEENMD09F",
+			DeviceDetails: &DeviceDetails{DeviceId: "DEVICE_2"},
+			CashDetails:   &CashDetails{BuyerSuppliedMoney: &AmountMoney{Amount: 700, Currency: "USD"}},
+		},
+		{
+			Id:          "cash-after-shift",
+			CreatedAt:   shift.EndedAt.Add(time.Minute),
+			Status:      PaymentStatusCompleted,
+			SourceType:  PaymentSourceTypeCash,
+			LocationId:  "P034NEENMD09F",
+			CashDetails: &CashDetails{BuyerSuppliedMoney: &AmountMoney{Amount: 500, Currency: "USD"}},
+		},
+		{
+			Id:         "card-in-shift",
+			CreatedAt:  openedAt,
+			Status:     PaymentStatusCompleted,
+			SourceType: "CARD",
+			LocationId: "P034NEENMD09F",
+		},
+	}
+
+	expected := &CashDrawerReconciliation{
+		ExpectedCashMoney: AmountMoney{Amount: 10000, Currency: "USD"},
+		ClosedCashMoney:   AmountMoney{Amount: 9970, Currency: "USD"},
+		DifferenceMoney:   AmountMoney{Amount: -30, Currency: "USD"},
+		PaymentIds:        []string{"cash-in-shift"},
+	}
+	if r := shift.Reconcile(payments); !reflect.DeepEqual(r, expected) {
+		t.Errorf("Reconcile returned %+v, expected %+v", r, expected)
+	}
+
+	shift.Device = nil
+	expected.ExpectedCashMoney.Amount, expected.DifferenceMoney.Amount = 10700, -730
+	expected.PaymentIds = []string{"cash-in-shift", "cash-other-drawer"}
+	if r := shift.Reconcile(payments); !reflect.DeepEqual(r, expected) {
+		t.Errorf("Reconcile without device returned %+v, expected %+v", r, expected)
+	}
+}
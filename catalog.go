@@ -0,0 +1,198 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	CatalogBasePath = "v2/catalog"
+
+	catalogObjectPath = "object"
+)
+
+// Catalog object types.
+const (
+	CatalogObjectTypeItem          = "ITEM"
+	CatalogObjectTypeItemVariation = "ITEM_VARIATION"
+	CatalogObjectTypeCategory      = "CATEGORY"
+)
+
+// Catalog item variation pricing types.
+const (
+	CatalogPricingTypeFixed    = "FIXED_PRICING"
+	CatalogPricingTypeVariable = "VARIABLE_PRICING"
+)
+
+// CatalogService is an interface for interfacing with the object endpoints of the Square Catalog API.
+type CatalogService interface {
+	RetrieveCatalogObject(ctx context.Context, objectId string) (*CatalogObject, *Response, error)
+	UpsertCatalogObject(ctx context.Context, upsert *UpsertCatalogObject) (*UpsertedCatalogObject, *Response, error)
+	DeleteCatalogObject(ctx context.Context, objectId string) (*DeletedCatalogObjects, *Response, error)
+}
+
+var _ CatalogService = &CatalogServiceOp{}
+
+// CatalogServiceOp handles communication with the catalog related methods of the Square API.
+type CatalogServiceOp struct {
+	client *Client
+}
+
+// CatalogObject represents a catalog object.
+type CatalogObject struct {
+	Object *CatalogObjectEntry `json:"object"`
+}
+
+// CatalogObjectEntry represents an object of the catalog of a merchant. The data of the object is in the field
+// matching its Type; only items, item variations and categories are modelled.
+type CatalogObjectEntry struct {
+	Type                  string                `json:"type"`
+	Id                    string                `json:"id"`
+	UpdatedAt             *time.Time            `json:"updated_at,omitempty"`
+	Version               int64                 `json:"version,omitempty"`
+	IsDeleted             bool                  `json:"is_deleted,omitempty"`
+	PresentAtAllLocations *bool                 `json:"present_at_all_locations,omitempty"`
+	PresentAtLocationIds  []string              `json:"present_at_location_ids,omitempty"`
+	AbsentAtLocationIds   []string              `json:"absent_at_location_ids,omitempty"`
+	ItemData              *CatalogItem          `json:"item_data,omitempty"`
+	ItemVariationData     *CatalogItemVariation `json:"item_variation_data,omitempty"`
+	CategoryData          *CatalogCategory      `json:"category_data,omitempty"`
+}
+
+// CatalogItem represents the data of an item, with its variations.
+type CatalogItem struct {
+	Name         string               `json:"name,omitempty"`
+	Description  string               `json:"description,omitempty"`
+	Abbreviation string               `json:"abbreviation,omitempty"`
+	IsTaxable    *bool                `json:"is_taxable,omitempty"`
+	CategoryId   string               `json:"category_id,omitempty"`
+	TaxIds       []string             `json:"tax_ids,omitempty"`
+	Variations   []CatalogObjectEntry `json:"variations,omitempty"`
+	ProductType  string               `json:"product_type,omitempty"`
+}
+
+// CatalogItemVariation represents the data of a variation of an item.
+type CatalogItemVariation struct {
+	ItemId      string       `json:"item_id,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Sku         string       `json:"sku,omitempty"`
+	Ordinal     int          `json:"ordinal,omitempty"`
+	PricingType string       `json:"pricing_type,omitempty"`
+	PriceMoney  *AmountMoney `json:"price_money,omitempty"`
+}
+
+// CatalogCategory represents the data of a category.
+type CatalogCategory struct {
+	Name string `json:"name,omitempty"`
+}
+
+// UpsertCatalogObject represents a catalog object to be created or updated. New objects, and new objects
+// nested in them, are given a temporary ID starting with #, which the API maps to their permanent ID.
+type UpsertCatalogObject struct {
+	IdempotencyKey string              `json:"idempotency_key"`
+	Object         *CatalogObjectEntry `json:"object"`
+}
+
+// UpsertedCatalogObject represents the result of a catalog object upsert.
+type UpsertedCatalogObject struct {
+	CatalogObject *CatalogObjectEntry `json:"catalog_object"`
+	IdMappings    []CatalogIdMapping  `json:"id_mappings,omitempty"`
+}
+
+// CatalogIdMapping maps the temporary ID of a created object to its permanent ID.
+type CatalogIdMapping struct {
+	ClientObjectId string `json:"client_object_id"`
+	ObjectId       string `json:"object_id"`
+}
+
+// DeletedCatalogObjects represents the objects removed by a catalog object deletion: the object and the objects
+// depending on it, such as the variations of an item.
+type DeletedCatalogObjects struct {
+	DeletedObjectIds []string   `json:"deleted_object_ids"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+}
+
+// RetrieveCatalogObject returns a catalog object by ID. Catalog webhook events do not name the objects which
+// changed, so cached objects are only evicted by the writes of this service, and otherwise expire.
+func (s *CatalogServiceOp) RetrieveCatalogObject(ctx context.Context, objectId string) (*CatalogObject, *Response, error) {
+	if len(objectId) == 0 {
+		return nil, nil, NewArgError("objectId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeCatalogObject, objectId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(CatalogBasePath, catalogObjectPath, objectId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CatalogObject)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpsertCatalogObject creates or updates a catalog object. The object, and the variations of an item, are evicted
+// from the cache of the client.
+func (s *CatalogServiceOp) UpsertCatalogObject(ctx context.Context, upsert *UpsertCatalogObject) (*UpsertedCatalogObject, *Response, error) {
+	if upsert != nil && upsert.Object != nil && len(upsert.Object.Id) > 0 && !strings.HasPrefix(upsert.Object.Id, "#") {
+		ctx = withCache(ctx, cacheTypeCatalogObject, upsert.Object.Id)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(CatalogBasePath, catalogObjectPath), upsert)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(UpsertedCatalogObject)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if o := root.CatalogObject; o != nil && o.ItemData != nil {
+		for _, variation := range o.ItemData.Variations {
+			s.evict(variation.Id)
+		}
+	}
+
+	return root, resp, nil
+}
+
+// DeleteCatalogObject deletes a catalog object and the objects depending on it, evicting them all from the
+// cache of the client.
+func (s *CatalogServiceOp) DeleteCatalogObject(ctx context.Context, objectId string) (*DeletedCatalogObjects, *Response, error) {
+	if len(objectId) == 0 {
+		return nil, nil, NewArgError("objectId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeCatalogObject, objectId)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(CatalogBasePath, catalogObjectPath, objectId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(DeletedCatalogObjects)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, id := range root.DeletedObjectIds {
+		s.evict(id)
+	}
+
+	return root, resp, nil
+}
+
+// evict removes a catalog object changed along with the object of a request from the cache of the client.
+func (s *CatalogServiceOp) evict(objectId string) {
+	if s.client.cache == nil {
+		return
+	}
+	s.client.evict(s.client.cacheMerchant.cached(), cachedResource{typ: cacheTypeCatalogObject, id: objectId})
+}
@@ -0,0 +1,122 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCatalogServiceOp_UpsertCatalogObject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	upsert := &UpsertCatalogObject{
+		IdempotencyKey: "af3d1afc-7212-4300-b463-0bfc5314a5ae",
+		Object: &CatalogObjectEntry{
+			Type:     CatalogObjectTypeItem,
+			Id:       "#Cocoa",
+			ItemData: &CatalogItem{Name: "Cocoa", Description: "Hot Chocolate"},
+		},
+	}
+
+	mux.HandleFunc("/v2/catalog/object", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(UpsertCatalogObject), upsert)
+		fmt.Fprint(w, `{
+  "catalog_object": {"type": "ITEM", "id": "R2TA2FOBUGCJZNIWJSOSNAI4", "version": 1525440691004, "item_data": {"name": "Cocoa"}},
+  "id_mappings": [{"client_object_id": "#Cocoa", "object_id": "R2TA2FOBUGCJZNIWJSOSNAI4"}]
+}`)
+	})
+
+	got, _, err := client.Catalog.UpsertCatalogObject(ctx, upsert)
+	if err != nil {
+		t.Fatalf("Catalog.UpsertCatalogObject returned error: %v", err)
+	}
+
+	expected := &UpsertedCatalogObject{
+		CatalogObject: &CatalogObjectEntry{
+			Type:     CatalogObjectTypeItem,
+			Id:       "R2TA2FOBUGCJZNIWJSOSNAI4",
+			Version:  1525440691004,
+			ItemData: &CatalogItem{Name: "Cocoa"},
+		},
+		IdMappings: []CatalogIdMapping{{ClientObjectId: "#Cocoa", ObjectId: "R2TA2FOBUGCJZNIWJSOSNAI4"}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Catalog.UpsertCatalogObject returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCatalogServiceOp_cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "DM7VKY8Q63GNP"}
+
+	requests := make(map[string]int)
+	for _, id := range []string{"R2TA2FOBUGCJZNIWJSOSNAI4", "QRT53UP4LITLWGOGBZCUWP63"} {
+		id := id
+		mux.HandleFunc("/v2/catalog/object/"+id, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				fmt.Fprint(w, `{"deleted_object_ids":["R2TA2FOBUGCJZNIWJSOSNAI4","QRT53UP4LITLWGOGBZCUWP63"]}`)
+				return
+			}
+			testMethod(t, r, http.MethodGet)
+			requests[id]++
+			fmt.Fprintf(w, `{"object":{"type":"ITEM","id":%q}}`, id)
+		})
+	}
+	mux.HandleFunc("/v2/catalog/object", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"catalog_object":{"type":"ITEM","id":"R2TA2FOBUGCJZNIWJSOSNAI4","item_data":{
+  "variations":[{"type":"ITEM_VARIATION","id":"QRT53UP4LITLWGOGBZCUWP63"}]
+}}}`)
+	})
+
+	retrieve := func() {
+		t.Helper()
+		for _, id := range []string{"R2TA2FOBUGCJZNIWJSOSNAI4", "QRT53UP4LITLWGOGBZCUWP63"} {
+			got, _, err := client.Catalog.RetrieveCatalogObject(ctx, id)
+			if err != nil {
+				t.Fatalf("Catalog.RetrieveCatalogObject returned error: %v", err)
+			}
+			if got.Object.Id != id {
+				t.Errorf("Catalog.RetrieveCatalogObject(%q) returned %+v", id, got.Object)
+			}
+		}
+	}
+
+	retrieve()
+	retrieve()
+
+	upsert := &UpsertCatalogObject{
+		IdempotencyKey: "b2d5b0a1-4f0e-4d5c-8f5b-0f6c3a1e9b27",
+		Object:         &CatalogObjectEntry{Type: CatalogObjectTypeItem, Id: "R2TA2FOBUGCJZNIWJSOSNAI4"},
+	}
+	if _, _, err := client.Catalog.UpsertCatalogObject(ctx, upsert); err != nil {
+		t.Fatalf("Catalog.UpsertCatalogObject returned error: %v", err)
+	}
+	retrieve()
+
+	if _, _, err := client.Catalog.DeleteCatalogObject(ctx, "R2TA2FOBUGCJZNIWJSOSNAI4"); err != nil {
+		t.Fatalf("Catalog.DeleteCatalogObject returned error: %v", err)
+	}
+	retrieve()
+
+	expected := map[string]int{"R2TA2FOBUGCJZNIWJSOSNAI4": 3, "QRT53UP4LITLWGOGBZCUWP63": 3}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Catalog.RetrieveCatalogObject requests by object = %v, expected %v", requests, expected)
+	}
+
+	if _, _, err := client.Catalog.RetrieveCatalogObject(ctx, ""); err == nil {
+		t.Error("Catalog.RetrieveCatalogObject with an empty ID returned no error")
+	}
+	if _, _, err := client.Catalog.DeleteCatalogObject(ctx, ""); err == nil {
+		t.Error("Catalog.DeleteCatalogObject with an empty ID returned no error")
+	}
+}
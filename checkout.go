@@ -0,0 +1,409 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	CheckoutBasePath = "v2/online-checkout"
+
+	checkoutPaymentLinksPath     = "payment-links"
+	checkoutLocationSettingsPath = "location-settings"
+	checkoutMerchantSettingsPath = "merchant-settings"
+)
+
+// CheckoutService is an interface for interfacing with the Square Checkout API.
+type CheckoutService interface {
+	CreatePaymentLink(ctx context.Context, link *CreatePaymentLink) (*PaymentLink, *Response, error)
+	RetrievePaymentLink(ctx context.Context, linkId string) (*PaymentLink, *Response, error)
+	ListPaymentLinks(ctx context.Context, options *ListOptions) (*ListPaymentLinks, *Response, error)
+	UpdatePaymentLink(ctx context.Context, linkId string, link *UpdatePaymentLink) (*PaymentLink, *Response, error)
+	DeletePaymentLink(ctx context.Context, linkId string) (*DeletedPaymentLink, *Response, error)
+	RetrieveLocationSettings(ctx context.Context, locationId string) (*CheckoutLocationSettings, *Response, error)
+	UpdateLocationSettings(ctx context.Context, locationId string, settings *CheckoutLocationSettings) (*CheckoutLocationSettings, *Response, error)
+	RetrieveMerchantSettings(ctx context.Context) (*CheckoutMerchantSettings, *Response, error)
+	UpdateMerchantSettings(ctx context.Context, settings *CheckoutMerchantSettings) (*CheckoutMerchantSettings, *Response, error)
+}
+
+var _ CheckoutService = &CheckoutServiceOp{}
+
+// CheckoutServiceOp handles communication with the checkout related methods of the Square API.
+type CheckoutServiceOp struct {
+	client *Client
+}
+
+// ListPaymentLinks represents a list of payment links.
+type ListPaymentLinks struct {
+	PaymentLinks []PaymentLinkEntry `json:"payment_links"`
+	Cursor       string             `json:"cursor,omitempty"`
+}
+
+// PaymentLink represents a payment link.
+type PaymentLink struct {
+	PaymentLink      *PaymentLinkEntry            `json:"payment_link"`
+	RelatedResources *PaymentLinkRelatedResources `json:"related_resources,omitempty"`
+}
+
+// DeletedPaymentLink represents the result of deleting a payment link.
+type DeletedPaymentLink struct {
+	Id               string `json:"id"`
+	CancelledOrderId string `json:"cancelled_order_id,omitempty"`
+}
+
+// PaymentLinkEntry represents a Square-hosted checkout page.
+type PaymentLinkEntry struct {
+	Id               string                      `json:"id,omitempty"`
+	Version          int                         `json:"version"`
+	Description      string                      `json:"description,omitempty"`
+	OrderId          string                      `json:"order_id,omitempty"`
+	CheckoutOptions  *PaymentLinkCheckoutOptions `json:"checkout_options,omitempty"`
+	PrePopulatedData *PrePopulatedData           `json:"pre_populated_data,omitempty"`
+	Url              string                      `json:"url,omitempty"`
+	LongUrl          string                      `json:"long_url,omitempty"`
+	CreatedAt        *time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time                  `json:"updated_at,omitempty"`
+	PaymentNote      string                      `json:"payment_note,omitempty"`
+}
+
+// PaymentLinkRelatedResources represents the resources created along with a payment link.
+type PaymentLinkRelatedResources struct {
+	Orders []Order `json:"orders,omitempty"`
+}
+
+// PaymentLinkCheckoutOptions represents the options of a Square-hosted checkout page.
+type PaymentLinkCheckoutOptions struct {
+	AllowTipping           *bool                   `json:"allow_tipping,omitempty"`
+	CustomFields           []CheckoutCustomField   `json:"custom_fields,omitempty"`
+	SubscriptionPlanId     string                  `json:"subscription_plan_id,omitempty"`
+	RedirectUrl            string                  `json:"redirect_url,omitempty"`
+	MerchantSupportEmail   string                  `json:"merchant_support_email,omitempty"`
+	AskForShippingAddress  *bool                   `json:"ask_for_shipping_address,omitempty"`
+	AcceptedPaymentMethods *AcceptedPaymentMethods `json:"accepted_payment_methods,omitempty"`
+	AppFeeMoney            *AmountMoney            `json:"app_fee_money,omitempty"`
+	ShippingFee            *ShippingFee            `json:"shipping_fee,omitempty"`
+	EnableCoupon           *bool                   `json:"enable_coupon,omitempty"`
+	EnableLoyalty          *bool                   `json:"enable_loyalty,omitempty"`
+}
+
+// CheckoutCustomField represents a custom field shown to the buyer at checkout.
+type CheckoutCustomField struct {
+	Title string `json:"title"`
+}
+
+// AcceptedPaymentMethods represents the payment methods a buyer can use on a checkout page, in addition to cards.
+type AcceptedPaymentMethods struct {
+	ApplePay         *bool `json:"apple_pay,omitempty"`
+	GooglePay        *bool `json:"google_pay,omitempty"`
+	CashAppPay       *bool `json:"cash_app_pay,omitempty"`
+	AfterpayClearpay *bool `json:"afterpay_clearpay,omitempty"`
+}
+
+// ShippingFee represents the shipping fee charged at checkout.
+type ShippingFee struct {
+	Name   string       `json:"name,omitempty"`
+	Charge *AmountMoney `json:"charge"`
+}
+
+// PrePopulatedData represents the buyer information pre-filled on the checkout page.
+type PrePopulatedData struct {
+	BuyerEmail       string          `json:"buyer_email,omitempty"`
+	BuyerPhoneNumber string          `json:"buyer_phone_number,omitempty"`
+	BuyerAddress     *BillingAddress `json:"buyer_address,omitempty"`
+}
+
+// QuickPay represents a payment link for a single item at a fixed price, without an order.
+type QuickPay struct {
+	Name       string       `json:"name"`
+	PriceMoney *AmountMoney `json:"price_money"`
+	LocationId string       `json:"location_id"`
+}
+
+// Order represents the order a payment link collects payment for.
+type Order struct {
+	Id          string          `json:"id,omitempty"`
+	LocationId  string          `json:"location_id"`
+	ReferenceId string          `json:"reference_id,omitempty"`
+	CustomerId  string          `json:"customer_id,omitempty"`
+	LineItems   []OrderLineItem `json:"line_items,omitempty"`
+	State       string          `json:"state,omitempty"`
+	Version     int             `json:"version,omitempty"`
+	TotalMoney  *AmountMoney    `json:"total_money,omitempty"`
+	CreatedAt   *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time      `json:"updated_at,omitempty"`
+}
+
+// OrderLineItem represents a line item of an order.
+type OrderLineItem struct {
+	Uid             string       `json:"uid,omitempty"`
+	Name            string       `json:"name,omitempty"`
+	Quantity        string       `json:"quantity"`
+	Note            string       `json:"note,omitempty"`
+	CatalogObjectId string       `json:"catalog_object_id,omitempty"`
+	BasePriceMoney  *AmountMoney `json:"base_price_money,omitempty"`
+	TotalMoney      *AmountMoney `json:"total_money,omitempty"`
+}
+
+// CreatePaymentLink represents a payment link to be created. Exactly one of QuickPay or Order must be set.
+type CreatePaymentLink struct {
+	IdempotencyKey   string                      `json:"idempotency_key,omitempty"`
+	Description      string                      `json:"description,omitempty"`
+	QuickPay         *QuickPay                   `json:"quick_pay,omitempty"`
+	Order            *Order                      `json:"order,omitempty"`
+	CheckoutOptions  *PaymentLinkCheckoutOptions `json:"checkout_options,omitempty"`
+	PrePopulatedData *PrePopulatedData           `json:"pre_populated_data,omitempty"`
+	PaymentNote      string                      `json:"payment_note,omitempty"`
+}
+
+// UpdatePaymentLink represents a payment link to be updated. PaymentLink.Version must be the current version.
+type UpdatePaymentLink struct {
+	PaymentLink *PaymentLinkEntry `json:"payment_link"`
+}
+
+// CheckoutLocationSettings represents the checkout settings of a location.
+type CheckoutLocationSettings struct {
+	LocationSettings *LocationSettings `json:"location_settings"`
+}
+
+// LocationSettings represents the checkout page settings of a location.
+type LocationSettings struct {
+	LocationId           string            `json:"location_id,omitempty"`
+	CustomerNotesEnabled *bool             `json:"customer_notes_enabled,omitempty"`
+	Policies             []CheckoutPolicy  `json:"policies,omitempty"`
+	Branding             *CheckoutBranding `json:"branding,omitempty"`
+	Tipping              *CheckoutTipping  `json:"tipping,omitempty"`
+	Coupons              *CheckoutCoupons  `json:"coupons,omitempty"`
+	UpdatedAt            *time.Time        `json:"updated_at,omitempty"`
+}
+
+// CheckoutPolicy represents a policy displayed on the checkout page.
+type CheckoutPolicy struct {
+	Uid         string `json:"uid,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CheckoutBranding represents the look of the checkout page.
+type CheckoutBranding struct {
+	HeaderType  string `json:"header_type,omitempty"`
+	ButtonColor string `json:"button_color,omitempty"`
+	ButtonShape string `json:"button_shape,omitempty"`
+}
+
+// CheckoutTipping represents the tipping options of the checkout page.
+type CheckoutTipping struct {
+	Percentages         []int         `json:"percentages,omitempty"`
+	SmartTippingEnabled *bool         `json:"smart_tipping_enabled,omitempty"`
+	DefaultPercent      int           `json:"default_percent,omitempty"`
+	SmartTips           []AmountMoney `json:"smart_tips,omitempty"`
+	DefaultSmartTip     *AmountMoney  `json:"default_smart_tip,omitempty"`
+}
+
+// CheckoutCoupons represents the coupon settings of the checkout page.
+type CheckoutCoupons struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CheckoutMerchantSettings represents the checkout settings of the merchant.
+type CheckoutMerchantSettings struct {
+	MerchantSettings *MerchantSettings `json:"merchant_settings"`
+}
+
+// MerchantSettings represents the checkout settings applying to all locations of the merchant.
+type MerchantSettings struct {
+	PaymentMethods *MerchantPaymentMethods `json:"payment_methods,omitempty"`
+	UpdatedAt      *time.Time              `json:"updated_at,omitempty"`
+}
+
+// MerchantPaymentMethods represents the payment methods enabled for checkout pages.
+type MerchantPaymentMethods struct {
+	ApplePay         *PaymentMethodSetting `json:"apple_pay,omitempty"`
+	GooglePay        *PaymentMethodSetting `json:"google_pay,omitempty"`
+	CashApp          *PaymentMethodSetting `json:"cash_app,omitempty"`
+	AfterpayClearpay *PaymentMethodSetting `json:"afterpay_clearpay,omitempty"`
+}
+
+// PaymentMethodSetting represents whether a payment method is enabled.
+type PaymentMethodSetting struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CreatePaymentLink creates a Square-hosted checkout page, either for a quick pay amount or for an order.
+func (s *CheckoutServiceOp) CreatePaymentLink(ctx context.Context, link *CreatePaymentLink) (*PaymentLink, *Response, error) {
+	p := path.Join(CheckoutBasePath, checkoutPaymentLinksPath)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(PaymentLink)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrievePaymentLink returns a payment link by ID.
+func (s *CheckoutServiceOp) RetrievePaymentLink(ctx context.Context, linkId string) (*PaymentLink, *Response, error) {
+	if len(linkId) == 0 {
+		return nil, nil, NewArgError("linkId", "cannot be an empty string")
+	}
+
+	p := path.Join(CheckoutBasePath, checkoutPaymentLinksPath, linkId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(PaymentLink)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListPaymentLinks returns a list of the payment links of the merchant.
+func (s *CheckoutServiceOp) ListPaymentLinks(ctx context.Context, options *ListOptions) (*ListPaymentLinks, *Response, error) {
+	p, err := addOptions(path.Join(CheckoutBasePath, checkoutPaymentLinksPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListPaymentLinks)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdatePaymentLink updates a payment link. Only the description, checkout options and pre-populated data can
+// be changed.
+func (s *CheckoutServiceOp) UpdatePaymentLink(ctx context.Context, linkId string, link *UpdatePaymentLink) (*PaymentLink, *Response, error) {
+	if len(linkId) == 0 {
+		return nil, nil, NewArgError("linkId", "cannot be an empty string")
+	}
+
+	p := path.Join(CheckoutBasePath, checkoutPaymentLinksPath, linkId)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, p, link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(PaymentLink)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeletePaymentLink deletes a payment link, cancelling the order it was created with.
+func (s *CheckoutServiceOp) DeletePaymentLink(ctx context.Context, linkId string) (*DeletedPaymentLink, *Response, error) {
+	if len(linkId) == 0 {
+		return nil, nil, NewArgError("linkId", "cannot be an empty string")
+	}
+
+	p := path.Join(CheckoutBasePath, checkoutPaymentLinksPath, linkId)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(DeletedPaymentLink)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveLocationSettings returns the checkout settings of a location.
+func (s *CheckoutServiceOp) RetrieveLocationSettings(ctx context.Context, locationId string) (*CheckoutLocationSettings, *Response, error) {
+	if len(locationId) == 0 {
+		return nil, nil, NewArgError("locationId", "cannot be an empty string")
+	}
+
+	p := path.Join(CheckoutBasePath, checkoutLocationSettingsPath, locationId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CheckoutLocationSettings)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateLocationSettings updates the checkout settings of a location.
+func (s *CheckoutServiceOp) UpdateLocationSettings(ctx context.Context, locationId string, settings *CheckoutLocationSettings) (*CheckoutLocationSettings, *Response, error) {
+	if len(locationId) == 0 {
+		return nil, nil, NewArgError("locationId", "cannot be an empty string")
+	}
+
+	p := path.Join(CheckoutBasePath, checkoutLocationSettingsPath, locationId)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, p, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CheckoutLocationSettings)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveMerchantSettings returns the checkout settings of the merchant.
+func (s *CheckoutServiceOp) RetrieveMerchantSettings(ctx context.Context) (*CheckoutMerchantSettings, *Response, error) {
+	p := path.Join(CheckoutBasePath, checkoutMerchantSettingsPath)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CheckoutMerchantSettings)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateMerchantSettings updates the checkout settings of the merchant.
+func (s *CheckoutServiceOp) UpdateMerchantSettings(ctx context.Context, settings *CheckoutMerchantSettings) (*CheckoutMerchantSettings, *Response, error) {
+	p := path.Join(CheckoutBasePath, checkoutMerchantSettingsPath)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, p, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CheckoutMerchantSettings)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
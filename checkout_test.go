@@ -0,0 +1,380 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var (
+	paymentLinkResponse = `
+{
+  "payment_link": {
+    "id": "TN4BWEDJ9AI5MBIV",
+    "version": 1,
+    "description": "Auto Detailing",
+    "order_id": "Qqc6yppGvxVwc46Cch4zHTaJqc4F",
+    "checkout_options": {
+      "allow_tipping": false,
+      "ask_for_shipping_address": true
+    },
+    "url": "https://square.link/u/EXAMPLE",
+    "long_url": "https://checkout.square.site/EXAMPLE"
+  },
+  "related_resources": {
+    "orders": [
+      {
+        "id": "Qqc6yppGvxVwc46Cch4zHTaJqc4F",
+        "location_id": "A9Y43N9ABXZBP",
+        "state": "DRAFT",
+        "total_money": {
+          "amount": 12500,
+          "currency": "USD"
+        }
+      }
+    ]
+  }
+}`
+
+	locationSettingsResponse = `
+{
+  "location_settings": {
+    "location_id": "LOCATION_ID_1",
+    "customer_notes_enabled": false,
+    "tipping": {
+      "percentages": [15, 20, 25],
+      "smart_tipping_enabled": true,
+      "default_percent": 20
+    },
+    "coupons": {
+      "enabled": true
+    }
+  }
+}`
+)
+
+// testRawBody checks that the JSON body of r contains every expected fragment.
+func testRawBody(t *testing.T, r *http.Request, expected ...string) []byte {
+	t.Helper()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range expected {
+		if !strings.Contains(string(body), e) {
+			t.Errorf("Request body = %s, expected it to contain %s", body, e)
+		}
+	}
+	return body
+}
+
+func TestCheckoutServiceOp_CreatePaymentLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	link := &CreatePaymentLink{
+		IdempotencyKey: "cd9e25dc-d9f2-4430-aedb-61605070e95f",
+		QuickPay: &QuickPay{
+			Name:       "Auto Detailing",
+			PriceMoney: &AmountMoney{Amount: 12500, Currency: "USD"},
+			LocationId: "A9Y43N9ABXZBP",
+		},
+		CheckoutOptions: &PaymentLinkCheckoutOptions{
+			AllowTipping:          PtrTo(false),
+			AskForShippingAddress: PtrTo(true),
+		},
+	}
+
+	mux.HandleFunc("/v2/online-checkout/payment-links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body := testRawBody(t, r, `"allow_tipping":false`, `"ask_for_shipping_address":true`)
+
+		v := new(CreatePaymentLink)
+		if err := json.Unmarshal(body, v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, link) {
+			t.Errorf("Request body = %+v, expected %+v", v, link)
+		}
+
+		fmt.Fprint(w, paymentLinkResponse)
+	})
+
+	got, _, err := client.Checkout.CreatePaymentLink(ctx, link)
+	if err != nil {
+		t.Fatalf("Checkout.CreatePaymentLink returned error: %v", err)
+	}
+
+	expected := &PaymentLink{
+		PaymentLink: &PaymentLinkEntry{
+			Id:          "TN4BWEDJ9AI5MBIV",
+			Version:     1,
+			Description: "Auto Detailing",
+			OrderId:     "Qqc6yppGvxVwc46Cch4zHTaJqc4F",
+			CheckoutOptions: &PaymentLinkCheckoutOptions{
+				AllowTipping:          PtrTo(false),
+				AskForShippingAddress: PtrTo(true),
+			},
+			Url:     "https://square.link/u/EXAMPLE",
+			LongUrl: "https://checkout.square.site/EXAMPLE",
+		},
+		RelatedResources: &PaymentLinkRelatedResources{
+			Orders: []Order{
+				{
+					Id:         "Qqc6yppGvxVwc46Cch4zHTaJqc4F",
+					LocationId: "A9Y43N9ABXZBP",
+					State:      "DRAFT",
+					TotalMoney: &AmountMoney{Amount: 12500, Currency: "USD"},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Checkout.CreatePaymentLink returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCheckoutServiceOp_CreatePaymentLink_quickPayOrOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/online-checkout/payment-links", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("invalid payment link sent to the API")
+	})
+
+	quickPay := &QuickPay{
+		Name:       "Auto Detailing",
+		PriceMoney: &AmountMoney{Amount: 12500, Currency: "USD"},
+		LocationId: "A9Y43N9ABXZBP",
+	}
+	order := &Order{LocationId: "A9Y43N9ABXZBP"}
+
+	tests := []struct {
+		name     string
+		link     *CreatePaymentLink
+		expected []string
+	}{
+		{"neither", &CreatePaymentLink{}, []string{"quick_pay, order"}},
+		{"both", &CreatePaymentLink{QuickPay: quickPay, Order: order}, []string{"quick_pay, order"}},
+		{"incomplete quick pay", &CreatePaymentLink{QuickPay: &QuickPay{Name: "Auto Detailing"}}, []string{
+			"quick_pay.price_money",
+			"quick_pay.location_id",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.Checkout.CreatePaymentLink(ctx, tt.link)
+			testValidationArgs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestCheckoutServiceOp_RetrievePaymentLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/online-checkout/payment-links/TN4BWEDJ9AI5MBIV", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, paymentLinkResponse)
+	})
+
+	got, _, err := client.Checkout.RetrievePaymentLink(ctx, "TN4BWEDJ9AI5MBIV")
+	if err != nil {
+		t.Fatalf("Checkout.RetrievePaymentLink returned error: %v", err)
+	}
+	if got.PaymentLink.Id != "TN4BWEDJ9AI5MBIV" || *got.PaymentLink.CheckoutOptions.AllowTipping {
+		t.Errorf("Checkout.RetrievePaymentLink returned %+v", got.PaymentLink)
+	}
+
+	if _, _, err := client.Checkout.RetrievePaymentLink(ctx, ""); err == nil {
+		t.Error("Checkout.RetrievePaymentLink with an empty ID returned no error")
+	}
+}
+
+func TestCheckoutServiceOp_ListPaymentLinks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/online-checkout/payment-links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "CURSOR", "limit": "10"})
+		fmt.Fprint(w, `{"payment_links":[{"id":"TN4BWEDJ9AI5MBIV","version":2}],"cursor":"NEXT"}`)
+	})
+
+	got, _, err := client.Checkout.ListPaymentLinks(ctx, &ListOptions{Cursor: "CURSOR", Limit: 10})
+	if err != nil {
+		t.Fatalf("Checkout.ListPaymentLinks returned error: %v", err)
+	}
+
+	expected := &ListPaymentLinks{
+		PaymentLinks: []PaymentLinkEntry{{Id: "TN4BWEDJ9AI5MBIV", Version: 2}},
+		Cursor:       "NEXT",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Checkout.ListPaymentLinks returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCheckoutServiceOp_UpdatePaymentLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	update := &UpdatePaymentLink{
+		PaymentLink: &PaymentLinkEntry{
+			Version: 1,
+			CheckoutOptions: &PaymentLinkCheckoutOptions{
+				AllowTipping:  PtrTo(false),
+				EnableCoupon:  PtrTo(false),
+				EnableLoyalty: PtrTo(true),
+				AcceptedPaymentMethods: &AcceptedPaymentMethods{
+					ApplePay:   PtrTo(true),
+					CashAppPay: PtrTo(false),
+				},
+			},
+		},
+	}
+
+	mux.HandleFunc("/v2/online-checkout/payment-links/TN4BWEDJ9AI5MBIV", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body := testRawBody(t, r, `"allow_tipping":false`, `"enable_coupon":false`, `"cash_app_pay":false`)
+		if strings.Contains(string(body), "google_pay") {
+			t.Errorf("Request body = %s, expected unset google_pay to be omitted", body)
+		}
+
+		fmt.Fprint(w, paymentLinkResponse)
+	})
+
+	if _, _, err := client.Checkout.UpdatePaymentLink(ctx, "TN4BWEDJ9AI5MBIV", update); err != nil {
+		t.Fatalf("Checkout.UpdatePaymentLink returned error: %v", err)
+	}
+
+	if _, _, err := client.Checkout.UpdatePaymentLink(ctx, "", update); err == nil {
+		t.Error("Checkout.UpdatePaymentLink with an empty ID returned no error")
+	}
+}
+
+func TestCheckoutServiceOp_DeletePaymentLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/online-checkout/payment-links/TN4BWEDJ9AI5MBIV", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"id":"TN4BWEDJ9AI5MBIV","cancelled_order_id":"Qqc6yppGvxVwc46Cch4zHTaJqc4F"}`)
+	})
+
+	got, _, err := client.Checkout.DeletePaymentLink(ctx, "TN4BWEDJ9AI5MBIV")
+	if err != nil {
+		t.Fatalf("Checkout.DeletePaymentLink returned error: %v", err)
+	}
+
+	expected := &DeletedPaymentLink{Id: "TN4BWEDJ9AI5MBIV", CancelledOrderId: "Qqc6yppGvxVwc46Cch4zHTaJqc4F"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Checkout.DeletePaymentLink returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCheckoutServiceOp_RetrieveLocationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/online-checkout/location-settings/LOCATION_ID_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, locationSettingsResponse)
+	})
+
+	got, _, err := client.Checkout.RetrieveLocationSettings(ctx, "LOCATION_ID_1")
+	if err != nil {
+		t.Fatalf("Checkout.RetrieveLocationSettings returned error: %v", err)
+	}
+
+	expected := &CheckoutLocationSettings{
+		LocationSettings: &LocationSettings{
+			LocationId:           "LOCATION_ID_1",
+			CustomerNotesEnabled: PtrTo(false),
+			Tipping: &CheckoutTipping{
+				Percentages:         []int{15, 20, 25},
+				SmartTippingEnabled: PtrTo(true),
+				DefaultPercent:      20,
+			},
+			Coupons: &CheckoutCoupons{Enabled: true},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Checkout.RetrieveLocationSettings returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Checkout.RetrieveLocationSettings(ctx, ""); err == nil {
+		t.Error("Checkout.RetrieveLocationSettings with an empty ID returned no error")
+	}
+}
+
+func TestCheckoutServiceOp_UpdateLocationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	settings := &CheckoutLocationSettings{
+		LocationSettings: &LocationSettings{
+			CustomerNotesEnabled: PtrTo(false),
+			Tipping:              &CheckoutTipping{SmartTippingEnabled: PtrTo(false)},
+		},
+	}
+
+	mux.HandleFunc("/v2/online-checkout/location-settings/LOCATION_ID_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRawBody(t, r, `"customer_notes_enabled":false`, `"smart_tipping_enabled":false`)
+		fmt.Fprint(w, locationSettingsResponse)
+	})
+
+	if _, _, err := client.Checkout.UpdateLocationSettings(ctx, "LOCATION_ID_1", settings); err != nil {
+		t.Fatalf("Checkout.UpdateLocationSettings returned error: %v", err)
+	}
+
+	if _, _, err := client.Checkout.UpdateLocationSettings(ctx, "", settings); err == nil {
+		t.Error("Checkout.UpdateLocationSettings with an empty ID returned no error")
+	}
+}
+
+func TestCheckoutServiceOp_MerchantSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	settings := &CheckoutMerchantSettings{
+		MerchantSettings: &MerchantSettings{
+			PaymentMethods: &MerchantPaymentMethods{
+				ApplePay: &PaymentMethodSetting{Enabled: false},
+				CashApp:  &PaymentMethodSetting{Enabled: true},
+			},
+		},
+	}
+
+	mux.HandleFunc("/v2/online-checkout/merchant-settings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			testRawBody(t, r, `"apple_pay":{"enabled":false}`, `"cash_app":{"enabled":true}`)
+		} else {
+			testMethod(t, r, http.MethodGet)
+		}
+		fmt.Fprint(w, `{"merchant_settings":{"payment_methods":{"apple_pay":{"enabled":false},"cash_app":{"enabled":true}}}}`)
+	})
+
+	got, _, err := client.Checkout.RetrieveMerchantSettings(ctx)
+	if err != nil {
+		t.Fatalf("Checkout.RetrieveMerchantSettings returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, settings) {
+		t.Errorf("Checkout.RetrieveMerchantSettings returned %+v, expected %+v", got, settings)
+	}
+
+	got, _, err = client.Checkout.UpdateMerchantSettings(ctx, settings)
+	if err != nil {
+		t.Fatalf("Checkout.UpdateMerchantSettings returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, settings) {
+		t.Errorf("Checkout.UpdateMerchantSettings returned %+v, expected %+v", got, settings)
+	}
+}
@@ -0,0 +1,173 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Schemas of the custom attribute value types, referenced by the $ref of a custom attribute definition schema.
+const (
+	customAttributeSchemaBase = "https://developer-production-s.squarecdn.com/schemas/v1/common.json#squareup.common."
+
+	CustomAttributeSchemaString      = customAttributeSchemaBase + "String"
+	CustomAttributeSchemaNumber      = customAttributeSchemaBase + "Number"
+	CustomAttributeSchemaBoolean     = customAttributeSchemaBase + "Boolean"
+	CustomAttributeSchemaDate        = customAttributeSchemaBase + "Date"
+	CustomAttributeSchemaSelection   = customAttributeSchemaBase + "Selection"
+	CustomAttributeSchemaAddress     = customAttributeSchemaBase + "Address"
+	CustomAttributeSchemaEmail       = customAttributeSchemaBase + "Email"
+	CustomAttributeSchemaPhoneNumber = customAttributeSchemaBase + "PhoneNumber"
+)
+
+// Custom attribute visibilities, from the point of view of other applications than the owner of the definition.
+const (
+	CustomAttributeVisibilityHidden          = "VISIBILITY_HIDDEN"
+	CustomAttributeVisibilityReadOnly        = "VISIBILITY_READ_ONLY"
+	CustomAttributeVisibilityReadWriteValues = "VISIBILITY_READ_WRITE_VALUES"
+)
+
+// customAttributeDateLayout is the layout of Date custom attribute values.
+const customAttributeDateLayout = "2006-01-02"
+
+// CustomAttributeValueType is the set of Go types custom attribute values can be read and written as. Email and
+// phone number values are strings, selection values are the IDs of the selected options, and date values only
+// keep the date of the time.
+type CustomAttributeValueType interface {
+	string | bool | float64 | time.Time | []string | BillingAddress
+}
+
+// CustomAttributeDefinitionEntry represents the definition of a custom attribute: its key, name and value schema.
+type CustomAttributeDefinitionEntry struct {
+	Key         string                 `json:"key,omitempty"`
+	Schema      map[string]interface{} `json:"schema,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Visibility  string                 `json:"visibility,omitempty"`
+	Version     int                    `json:"version,omitempty"`
+	UpdatedAt   *time.Time             `json:"updated_at,omitempty"`
+	CreatedAt   *time.Time             `json:"created_at,omitempty"`
+}
+
+// CustomAttributeEntry represents the value of a custom attribute of a resource. Value holds the raw JSON value;
+// use CustomAttributeValue and NewCustomAttribute to read and write it as a Go type.
+type CustomAttributeEntry struct {
+	Key        string                          `json:"key,omitempty"`
+	Value      json.RawMessage                 `json:"value,omitempty"`
+	Version    int                             `json:"version,omitempty"`
+	Visibility string                          `json:"visibility,omitempty"`
+	Definition *CustomAttributeDefinitionEntry `json:"definition,omitempty"`
+	UpdatedAt  *time.Time                      `json:"updated_at,omitempty"`
+	CreatedAt  *time.Time                      `json:"created_at,omitempty"`
+}
+
+// NewCustomAttributeSchema returns the schema of a custom attribute definition of the given value type, e.g.
+// CustomAttributeSchemaString.
+func NewCustomAttributeSchema(ref string) map[string]interface{} {
+	return map[string]interface{}{"$ref": ref}
+}
+
+// NewSelectionSchema returns the schema of a selection custom attribute definition with the given option names,
+// of which at most maxItems can be selected.
+func NewSelectionSchema(names []string, maxItems int) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"$ref":  CustomAttributeSchemaSelection,
+			"names": names,
+		},
+		"maxItems":    maxItems,
+		"uniqueItems": true,
+	}
+}
+
+// NewCustomAttribute returns a custom attribute with the given key holding value.
+func NewCustomAttribute[T CustomAttributeValueType](key string, value T) (*CustomAttributeEntry, error) {
+	var v interface{} = value
+	switch x := v.(type) {
+	case float64:
+		// Number values are sent as decimal strings.
+		v = strconv.FormatFloat(x, 'f', -1, 64)
+	case time.Time:
+		v = x.Format(customAttributeDateLayout)
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &CustomAttributeEntry{Key: key, Value: raw}, nil
+}
+
+// CustomAttributeValue returns the value of the custom attribute as T. T must match the schema of the
+// definition of the attribute.
+func CustomAttributeValue[T CustomAttributeValueType](a *CustomAttributeEntry) (T, error) {
+	var value T
+	if a == nil || len(a.Value) == 0 {
+		return value, fmt.Errorf("squareup: custom attribute has no value")
+	}
+
+	switch v := interface{}(&value).(type) {
+	case *float64:
+		// Number values are received as decimal strings, but accept JSON numbers too.
+		var n json.Number
+		if err := json.Unmarshal(a.Value, &n); err != nil {
+			return value, err
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return value, err
+		}
+		*v = f
+	case *time.Time:
+		var s string
+		if err := json.Unmarshal(a.Value, &s); err != nil {
+			return value, err
+		}
+		t, err := time.Parse(customAttributeDateLayout, s)
+		if err != nil {
+			return value, err
+		}
+		*v = t
+	default:
+		if err := json.Unmarshal(a.Value, &value); err != nil {
+			return value, err
+		}
+	}
+	return value, nil
+}
+
+// ListCustomAttributeDefinitions represents a list of custom attribute definitions.
+type ListCustomAttributeDefinitions struct {
+	CustomAttributeDefinitions []CustomAttributeDefinitionEntry `json:"custom_attribute_definitions"`
+	Cursor                     string                           `json:"cursor,omitempty"`
+}
+
+// CustomAttributeDefinition represents a custom attribute definition.
+type CustomAttributeDefinition struct {
+	CustomAttributeDefinition *CustomAttributeDefinitionEntry `json:"custom_attribute_definition"`
+}
+
+// UpsertCustomAttributeDefinition represents a custom attribute definition to be created or updated.
+type UpsertCustomAttributeDefinition struct {
+	CustomAttributeDefinition *CustomAttributeDefinitionEntry `json:"custom_attribute_definition"`
+	IdempotencyKey            string                          `json:"idempotency_key,omitempty"`
+}
+
+// ListCustomAttributes represents a list of custom attributes.
+type ListCustomAttributes struct {
+	CustomAttributes []CustomAttributeEntry `json:"custom_attributes"`
+	Cursor           string                 `json:"cursor,omitempty"`
+}
+
+// CustomAttribute represents a custom attribute.
+type CustomAttribute struct {
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute"`
+}
+
+// UpsertCustomAttribute represents a custom attribute to be created or updated.
+type UpsertCustomAttribute struct {
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute"`
+	IdempotencyKey  string                `json:"idempotency_key,omitempty"`
+}
@@ -0,0 +1,382 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+)
+
+const (
+	OrderBasePath    = "v2/orders"
+	LocationBasePath = "v2/locations"
+
+	customAttributeDefinitionsPath = "custom-attribute-definitions"
+	customAttributesPath           = "custom-attributes"
+	customAttributesBulkUpsertPath = "bulk-upsert"
+	customAttributesBulkDeletePath = "bulk-delete"
+)
+
+// BaseCustomAttributeService is an interface for interfacing with the Square Custom Attributes APIs. Customers,
+// orders, locations, merchants and bookings share the same custom attribute endpoints below their base path; T
+// is the bulk value type of the resource, e.g. CustomerBulkCustomAttribute.
+type BaseCustomAttributeService[T any] interface {
+	ListDefinitions(ctx context.Context, options *ListOptions) (*ListCustomAttributeDefinitions, *Response, error)
+	ListAllDefinitions(ctx context.Context, options *ListOptions) ([]CustomAttributeDefinitionEntry, error)
+	CreateDefinition(ctx context.Context, definition *UpsertCustomAttributeDefinition) (*CustomAttributeDefinition, *Response, error)
+	RetrieveDefinition(ctx context.Context, key string) (*CustomAttributeDefinition, *Response, error)
+	UpdateDefinition(ctx context.Context, key string, definition *UpsertCustomAttributeDefinition) (*CustomAttributeDefinition, *Response, error)
+	DeleteDefinition(ctx context.Context, key string) (*Response, error)
+
+	List(ctx context.Context, resourceId string, options *ListOptions) (*ListCustomAttributes, *Response, error)
+	ListAll(ctx context.Context, resourceId string, options *ListOptions) ([]CustomAttributeEntry, error)
+	Retrieve(ctx context.Context, resourceId, key string) (*CustomAttribute, *Response, error)
+	Upsert(ctx context.Context, resourceId, key string, attribute *UpsertCustomAttribute) (*CustomAttribute, *Response, error)
+	Delete(ctx context.Context, resourceId, key string) (*Response, error)
+	BulkUpsert(ctx context.Context, values map[string]T) (*BulkCustomAttributes[T], *Response, error)
+}
+
+// CustomAttributeService is a BaseCustomAttributeService of a resource whose custom attributes can also be
+// deleted in bulk.
+type CustomAttributeService[T any] interface {
+	BaseCustomAttributeService[T]
+	BulkDelete(ctx context.Context, values map[string]T) (*BulkCustomAttributes[T], *Response, error)
+}
+
+// CustomerCustomAttributeService is the custom attribute service of customers, which have no bulk delete
+// endpoint.
+type CustomerCustomAttributeService interface {
+	BaseCustomAttributeService[CustomerBulkCustomAttribute]
+}
+
+var (
+	_ CustomerCustomAttributeService                   = &CustomAttributeServiceOp[CustomerBulkCustomAttribute]{}
+	_ CustomAttributeService[OrderBulkCustomAttribute] = &CustomAttributeServiceOp[OrderBulkCustomAttribute]{}
+)
+
+// CustomAttributeServiceOp handles communication with the custom attribute related methods of the Square API
+// for the resource at basePath. Custom attributes are upserted with upsertMethod, which is PUT for bookings and
+// POST for the other resources.
+type CustomAttributeServiceOp[T any] struct {
+	client       *Client
+	basePath     string
+	upsertMethod string
+}
+
+// BulkCustomAttributes represents the values of a bulk custom attribute request or its result, keyed by a
+// client generated key.
+type BulkCustomAttributes[T any] struct {
+	Values map[string]T `json:"values"`
+	Errors []APIError   `json:"errors,omitempty"`
+}
+
+// CustomerBulkCustomAttribute represents a custom attribute of a customer in a bulk request or its result.
+// Bulk delete is not supported for customers.
+type CustomerBulkCustomAttribute struct {
+	CustomerId      string                `json:"customer_id,omitempty"`
+	Key             string                `json:"key,omitempty"`
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute,omitempty"`
+	IdempotencyKey  string                `json:"idempotency_key,omitempty"`
+	Errors          []APIError            `json:"errors,omitempty"`
+}
+
+// OrderBulkCustomAttribute represents a custom attribute of an order in a bulk request or its result.
+type OrderBulkCustomAttribute struct {
+	OrderId         string                `json:"order_id,omitempty"`
+	Key             string                `json:"key,omitempty"`
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute,omitempty"`
+	IdempotencyKey  string                `json:"idempotency_key,omitempty"`
+	Errors          []APIError            `json:"errors,omitempty"`
+}
+
+// LocationBulkCustomAttribute represents a custom attribute of a location in a bulk request or its result.
+type LocationBulkCustomAttribute struct {
+	LocationId      string                `json:"location_id,omitempty"`
+	Key             string                `json:"key,omitempty"`
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute,omitempty"`
+	IdempotencyKey  string                `json:"idempotency_key,omitempty"`
+	Errors          []APIError            `json:"errors,omitempty"`
+}
+
+// MerchantBulkCustomAttribute represents a custom attribute of a merchant in a bulk request or its result.
+type MerchantBulkCustomAttribute struct {
+	MerchantId      string                `json:"merchant_id,omitempty"`
+	Key             string                `json:"key,omitempty"`
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute,omitempty"`
+	IdempotencyKey  string                `json:"idempotency_key,omitempty"`
+	Errors          []APIError            `json:"errors,omitempty"`
+}
+
+// BookingBulkCustomAttribute represents a custom attribute of a booking in a bulk request or its result.
+type BookingBulkCustomAttribute struct {
+	BookingId       string                `json:"booking_id,omitempty"`
+	Key             string                `json:"key,omitempty"`
+	CustomAttribute *CustomAttributeEntry `json:"custom_attribute,omitempty"`
+	IdempotencyKey  string                `json:"idempotency_key,omitempty"`
+	Errors          []APIError            `json:"errors,omitempty"`
+}
+
+// ListDefinitions returns a list of the custom attribute definitions of the resource.
+func (s *CustomAttributeServiceOp[T]) ListDefinitions(ctx context.Context, options *ListOptions) (*ListCustomAttributeDefinitions, *Response, error) {
+	p, err := addOptions(path.Join(s.basePath, customAttributeDefinitionsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListCustomAttributeDefinitions)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListAllDefinitions returns all custom attribute definitions of the resource, following the cursor from page
+// to page.
+func (s *CustomAttributeServiceOp[T]) ListAllDefinitions(ctx context.Context, options *ListOptions) ([]CustomAttributeDefinitionEntry, error) {
+	opt := ListOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	var definitions []CustomAttributeDefinitionEntry
+	for {
+		page, _, err := s.ListDefinitions(ctx, &opt)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = append(definitions, page.CustomAttributeDefinitions...)
+		if len(page.Cursor) == 0 {
+			return definitions, nil
+		}
+		opt.Cursor = page.Cursor
+	}
+}
+
+// CreateDefinition creates a custom attribute definition for the resource.
+func (s *CustomAttributeServiceOp[T]) CreateDefinition(ctx context.Context, definition *UpsertCustomAttributeDefinition) (*CustomAttributeDefinition, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(s.basePath, customAttributeDefinitionsPath), definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomAttributeDefinition)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveDefinition returns a custom attribute definition by key.
+func (s *CustomAttributeServiceOp[T]) RetrieveDefinition(ctx context.Context, key string) (*CustomAttributeDefinition, *Response, error) {
+	if len(key) == 0 {
+		return nil, nil, NewArgError("key", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(s.basePath, customAttributeDefinitionsPath, key), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomAttributeDefinition)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateDefinition updates a custom attribute definition.
+func (s *CustomAttributeServiceOp[T]) UpdateDefinition(ctx context.Context, key string, definition *UpsertCustomAttributeDefinition) (*CustomAttributeDefinition, *Response, error) {
+	if len(key) == 0 {
+		return nil, nil, NewArgError("key", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(s.basePath, customAttributeDefinitionsPath, key), definition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomAttributeDefinition)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteDefinition deletes a custom attribute definition, along with its values on all resources.
+func (s *CustomAttributeServiceOp[T]) DeleteDefinition(ctx context.Context, key string) (*Response, error) {
+	if len(key) == 0 {
+		return nil, NewArgError("key", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(s.basePath, customAttributeDefinitionsPath, key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// List returns the custom attributes of a resource.
+func (s *CustomAttributeServiceOp[T]) List(ctx context.Context, resourceId string, options *ListOptions) (*ListCustomAttributes, *Response, error) {
+	if len(resourceId) == 0 {
+		return nil, nil, NewArgError("resourceId", "cannot be an empty string")
+	}
+
+	p, err := addOptions(path.Join(s.basePath, resourceId, customAttributesPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListCustomAttributes)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListAll returns all custom attributes of a resource, following the cursor from page to page.
+func (s *CustomAttributeServiceOp[T]) ListAll(ctx context.Context, resourceId string, options *ListOptions) ([]CustomAttributeEntry, error) {
+	opt := ListOptions{}
+	if options != nil {
+		opt = *options
+	}
+
+	var attributes []CustomAttributeEntry
+	for {
+		page, _, err := s.List(ctx, resourceId, &opt)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes = append(attributes, page.CustomAttributes...)
+		if len(page.Cursor) == 0 {
+			return attributes, nil
+		}
+		opt.Cursor = page.Cursor
+	}
+}
+
+// Retrieve returns a custom attribute of a resource by key.
+func (s *CustomAttributeServiceOp[T]) Retrieve(ctx context.Context, resourceId, key string) (*CustomAttribute, *Response, error) {
+	p, err := s.attributePath(resourceId, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomAttribute)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// Upsert creates or updates a custom attribute of a resource.
+func (s *CustomAttributeServiceOp[T]) Upsert(ctx context.Context, resourceId, key string, attribute *UpsertCustomAttribute) (*CustomAttribute, *Response, error) {
+	p, err := s.attributePath(resourceId, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, s.upsertMethod, p, attribute)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomAttribute)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// Delete deletes a custom attribute of a resource.
+func (s *CustomAttributeServiceOp[T]) Delete(ctx context.Context, resourceId, key string) (*Response, error) {
+	p, err := s.attributePath(resourceId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// BulkUpsert creates or updates custom attributes of several resources at once. Each value succeeds or fails
+// independently.
+func (s *CustomAttributeServiceOp[T]) BulkUpsert(ctx context.Context, values map[string]T) (*BulkCustomAttributes[T], *Response, error) {
+	return s.bulk(ctx, customAttributesBulkUpsertPath, values)
+}
+
+// BulkDelete deletes custom attributes of several resources at once. Each value identifies a custom attribute by
+// resource ID and key, and succeeds or fails independently. Customers have no bulk delete endpoint.
+func (s *CustomAttributeServiceOp[T]) BulkDelete(ctx context.Context, values map[string]T) (*BulkCustomAttributes[T], *Response, error) {
+	if s.basePath == CustomerBasePath {
+		return nil, nil, NewArgError("values", "cannot be deleted in bulk for customers")
+	}
+
+	return s.bulk(ctx, customAttributesBulkDeletePath, values)
+}
+
+// bulk posts values to the bulk endpoint at p.
+func (s *CustomAttributeServiceOp[T]) bulk(ctx context.Context, p string, values map[string]T) (*BulkCustomAttributes[T], *Response, error) {
+	if len(values) == 0 {
+		return nil, nil, NewArgError("values", "cannot be empty")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(s.basePath, customAttributesPath, p), &BulkCustomAttributes[T]{Values: values})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BulkCustomAttributes[T])
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// attributePath returns the path of a custom attribute of a resource.
+func (s *CustomAttributeServiceOp[T]) attributePath(resourceId, key string) (string, error) {
+	if len(resourceId) == 0 {
+		return "", NewArgError("resourceId", "cannot be an empty string")
+	}
+	if len(key) == 0 {
+		return "", NewArgError("key", "cannot be an empty string")
+	}
+
+	return path.Join(s.basePath, resourceId, customAttributesPath, key), nil
+}
@@ -0,0 +1,217 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCustomAttributeValue(t *testing.T) {
+	date := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value interface{}
+		raw   string
+	}{
+		{"Gold", `"Gold"`},
+		{true, `true`},
+		{12.5, `"12.5"`},
+		{date, `"2024-06-01"`},
+		{[]string{"4a2c1b8e-7f52-4e21-a0b1-4c0bb3b2d7a6"}, `["4a2c1b8e-7f52-4e21-a0b1-4c0bb3b2d7a6"]`},
+	}
+
+	for _, tt := range tests {
+		var (
+			a   *CustomAttributeEntry
+			got interface{}
+			err error
+		)
+
+		switch v := tt.value.(type) {
+		case string:
+			a, err = NewCustomAttribute("key", v)
+			if err == nil {
+				got, err = CustomAttributeValue[string](a)
+			}
+		case bool:
+			a, err = NewCustomAttribute("key", v)
+			if err == nil {
+				got, err = CustomAttributeValue[bool](a)
+			}
+		case float64:
+			a, err = NewCustomAttribute("key", v)
+			if err == nil {
+				got, err = CustomAttributeValue[float64](a)
+			}
+		case time.Time:
+			a, err = NewCustomAttribute("key", v)
+			if err == nil {
+				got, err = CustomAttributeValue[time.Time](a)
+			}
+		case []string:
+			a, err = NewCustomAttribute("key", v)
+			if err == nil {
+				got, err = CustomAttributeValue[[]string](a)
+			}
+		}
+		if err != nil {
+			t.Fatalf("%v: %v", tt.value, err)
+		}
+
+		if string(a.Value) != tt.raw {
+			t.Errorf("NewCustomAttribute(%v) value = %s, expected %s", tt.value, a.Value, tt.raw)
+		}
+		if !reflect.DeepEqual(got, tt.value) {
+			t.Errorf("CustomAttributeValue = %v, expected %v", got, tt.value)
+		}
+	}
+}
+
+func TestCustomerCustomAttributeServiceOp_Upsert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attribute, err := NewCustomAttribute("favorite-drink", "Double-shot breve")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/v2/customers/Z57QXKM2GBGTJ0B4N8A3XGSKQT/custom-attributes/favorite-drink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(UpsertCustomAttribute)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if string(v.CustomAttribute.Value) != `"Double-shot breve"` {
+			t.Errorf("Request value = %s", v.CustomAttribute.Value)
+		}
+
+		fmt.Fprint(w, `{"custom_attribute":{"key":"favorite-drink","version":1,"value":"Double-shot breve","visibility":"VISIBILITY_READ_WRITE_VALUES"}}`)
+	})
+
+	got, _, err := client.CustomerCustomAttribute.Upsert(ctx, "Z57QXKM2GBGTJ0B4N8A3XGSKQT", "favorite-drink", &UpsertCustomAttribute{CustomAttribute: attribute})
+	if err != nil {
+		t.Fatalf("CustomerCustomAttribute.Upsert returned error: %v", err)
+	}
+
+	value, err := CustomAttributeValue[string](got.CustomAttribute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "Double-shot breve" || got.CustomAttribute.Version != 1 {
+		t.Errorf("CustomerCustomAttribute.Upsert returned %+v", got.CustomAttribute)
+	}
+}
+
+func TestBookingCustomAttributeServiceOp_Upsert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attribute, err := NewCustomAttribute("favorite-stylist", "Sam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upsert := &UpsertCustomAttribute{CustomAttribute: attribute, IdempotencyKey: "b1f6b4e2-9d0c-4c1e-8c4e-7a8d7f3e2a10"}
+
+	mux.HandleFunc("/v2/bookings/zkras0xv0xwswx/custom-attributes/favorite-stylist", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testJSONBody(t, r, new(UpsertCustomAttribute), upsert)
+		fmt.Fprint(w, `{"custom_attribute":{"key":"favorite-stylist","version":1,"value":"Sam"}}`)
+	})
+
+	got, _, err := client.BookingCustomAttribute.Upsert(ctx, "zkras0xv0xwswx", "favorite-stylist", upsert)
+	if err != nil {
+		t.Fatalf("BookingCustomAttribute.Upsert returned error: %v", err)
+	}
+	if got.CustomAttribute.Key != "favorite-stylist" || got.CustomAttribute.Version != 1 {
+		t.Errorf("BookingCustomAttribute.Upsert returned %+v", got.CustomAttribute)
+	}
+}
+
+func TestCustomerCustomAttributeService_noBulkDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, ok := reflect.TypeOf((*CustomerCustomAttributeService)(nil)).Elem().MethodByName("BulkDelete"); ok {
+		t.Error("CustomerCustomAttributeService has a BulkDelete method")
+	}
+
+	mux.HandleFunc("/v2/customers/custom-attributes/bulk-delete", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("customer custom attributes deleted in bulk")
+	})
+
+	op := client.CustomerCustomAttribute.(*CustomAttributeServiceOp[CustomerBulkCustomAttribute])
+	values := map[string]CustomerBulkCustomAttribute{"1": {CustomerId: "Z57QXKM2GBGTJ0B4N8A3XGSKQT", Key: "favorite-drink"}}
+	if _, _, err := op.BulkDelete(ctx, values); err == nil {
+		t.Error("BulkDelete of customer custom attributes returned no error")
+	}
+}
+
+func TestCustomAttributeServiceOp_BulkUpsert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attribute, err := NewCustomAttribute("table-number", 12.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/v2/orders/custom-attributes/bulk-upsert", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(BulkCustomAttributes[OrderBulkCustomAttribute])
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if v.Values["1"].OrderId != "7BbXGEIWNldxAzrtGf9GPVZTwZ4F" {
+			t.Errorf("Request values = %+v", v.Values)
+		}
+
+		fmt.Fprint(w, `{"values":{"1":{"order_id":"7BbXGEIWNldxAzrtGf9GPVZTwZ4F","custom_attribute":{"key":"table-number","value":"12","version":1}}}}`)
+	})
+
+	got, _, err := client.OrderCustomAttribute.BulkUpsert(ctx, map[string]OrderBulkCustomAttribute{
+		"1": {OrderId: "7BbXGEIWNldxAzrtGf9GPVZTwZ4F", CustomAttribute: attribute},
+	})
+	if err != nil {
+		t.Fatalf("OrderCustomAttribute.BulkUpsert returned error: %v", err)
+	}
+
+	value, err := CustomAttributeValue[float64](got.Values["1"].CustomAttribute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 12 {
+		t.Errorf("OrderCustomAttribute.BulkUpsert value = %v, expected 12", value)
+	}
+}
+
+func TestCustomAttributeServiceOp_ListAllDefinitions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/locations/custom-attribute-definitions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if r.FormValue("cursor") == "" {
+			fmt.Fprint(w, `{"custom_attribute_definitions":[{"key":"bestseller"}],"cursor":"page2"}`)
+			return
+		}
+		testFormValues(t, r, values{"cursor": "page2", "limit": "1"})
+		fmt.Fprint(w, `{"custom_attribute_definitions":[{"key":"phone-extension"}]}`)
+	})
+
+	got, err := client.LocationCustomAttribute.ListAllDefinitions(ctx, &ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("LocationCustomAttribute.ListAllDefinitions returned error: %v", err)
+	}
+
+	expected := []CustomAttributeDefinitionEntry{{Key: "bestseller"}, {Key: "phone-extension"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("LocationCustomAttribute.ListAllDefinitions returned %+v, expected %+v", got, expected)
+	}
+}
@@ -0,0 +1,196 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	CustomerBasePath = "v2/customers"
+
+	customerGroupsPath   = "groups"
+	customerSegmentsPath = "segments"
+)
+
+// CustomerGroupService is an interface for interfacing with the Square Customer Groups API.
+type CustomerGroupService interface {
+	ListCustomerGroups(ctx context.Context, options *ListOptions) (*ListCustomerGroups, *Response, error)
+	CreateCustomerGroup(ctx context.Context, group *CreateCustomerGroup) (*CustomerGroup, *Response, error)
+	RetrieveCustomerGroup(ctx context.Context, groupId string) (*CustomerGroup, *Response, error)
+	UpdateCustomerGroup(ctx context.Context, groupId string, group *CustomerGroupEntry) (*CustomerGroup, *Response, error)
+	DeleteCustomerGroup(ctx context.Context, groupId string) (*Response, error)
+	AddGroupToCustomer(ctx context.Context, customerId, groupId string) (*Response, error)
+	RemoveGroupFromCustomer(ctx context.Context, customerId, groupId string) (*Response, error)
+}
+
+var _ CustomerGroupService = &CustomerGroupServiceOp{}
+
+// CustomerGroupServiceOp handles communication with the customer group related methods of the Square API.
+type CustomerGroupServiceOp struct {
+	client *Client
+}
+
+// ListCustomerGroups represents a list of customer groups.
+type ListCustomerGroups struct {
+	Groups []CustomerGroupEntry `json:"groups"`
+	Cursor string               `json:"cursor,omitempty"`
+}
+
+// CustomerGroup represents a customer group.
+type CustomerGroup struct {
+	Group *CustomerGroupEntry `json:"group"`
+}
+
+// CustomerGroupEntry represents a group of customers managed by the seller.
+type CustomerGroupEntry struct {
+	Id        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CreateCustomerGroup represents a customer group to be created.
+type CreateCustomerGroup struct {
+	IdempotencyKey string              `json:"idempotency_key,omitempty"`
+	Group          *CustomerGroupEntry `json:"group"`
+}
+
+// UpdateCustomerGroup represents the changes to a customer group.
+type UpdateCustomerGroup struct {
+	Group *CustomerGroupEntry `json:"group"`
+}
+
+// ListCustomerGroups returns a list of the customer groups of the seller.
+func (s *CustomerGroupServiceOp) ListCustomerGroups(ctx context.Context, options *ListOptions) (*ListCustomerGroups, *Response, error) {
+	p, err := addOptions(path.Join(CustomerBasePath, customerGroupsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListCustomerGroups)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateCustomerGroup creates a customer group.
+func (s *CustomerGroupServiceOp) CreateCustomerGroup(ctx context.Context, group *CreateCustomerGroup) (*CustomerGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(CustomerBasePath, customerGroupsPath), group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomerGroup)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveCustomerGroup returns a customer group by ID.
+func (s *CustomerGroupServiceOp) RetrieveCustomerGroup(ctx context.Context, groupId string) (*CustomerGroup, *Response, error) {
+	if len(groupId) == 0 {
+		return nil, nil, NewArgError("groupId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(CustomerBasePath, customerGroupsPath, groupId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomerGroup)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateCustomerGroup updates a customer group.
+func (s *CustomerGroupServiceOp) UpdateCustomerGroup(ctx context.Context, groupId string, group *CustomerGroupEntry) (*CustomerGroup, *Response, error) {
+	if len(groupId) == 0 {
+		return nil, nil, NewArgError("groupId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(CustomerBasePath, customerGroupsPath, groupId), &UpdateCustomerGroup{Group: group})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomerGroup)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteCustomerGroup deletes a customer group. Its customers are kept.
+func (s *CustomerGroupServiceOp) DeleteCustomerGroup(ctx context.Context, groupId string) (*Response, error) {
+	if len(groupId) == 0 {
+		return nil, NewArgError("groupId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(CustomerBasePath, customerGroupsPath, groupId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddGroupToCustomer adds a customer to a customer group.
+func (s *CustomerGroupServiceOp) AddGroupToCustomer(ctx context.Context, customerId, groupId string) (*Response, error) {
+	p, err := customerGroupPath(customerId, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveGroupFromCustomer removes a customer from a customer group.
+func (s *CustomerGroupServiceOp) RemoveGroupFromCustomer(ctx context.Context, customerId, groupId string) (*Response, error) {
+	p, err := customerGroupPath(customerId, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// customerGroupPath returns the path of the membership of a customer in a customer group.
+func customerGroupPath(customerId, groupId string) (string, error) {
+	if len(customerId) == 0 {
+		return "", NewArgError("customerId", "cannot be an empty string")
+	}
+	if len(groupId) == 0 {
+		return "", NewArgError("groupId", "cannot be an empty string")
+	}
+
+	return path.Join(CustomerBasePath, customerId, customerGroupsPath, groupId), nil
+}
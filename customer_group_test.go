@@ -0,0 +1,162 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var customerGroupResponse = `{"group":{"id":"2TAT3CMH4Q0A9M87XJZED0WMR3","name":"Loyal Customers"}}`
+
+func expectedCustomerGroup() *CustomerGroup {
+	return &CustomerGroup{Group: &CustomerGroupEntry{Id: "2TAT3CMH4Q0A9M87XJZED0WMR3", Name: "Loyal Customers"}}
+}
+
+func TestCustomerGroupServiceOp_ListCustomerGroups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/customers/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "cursor-1", "limit": "10"})
+		fmt.Fprint(w, `{"groups":[{"id":"2TAT3CMH4Q0A9M87XJZED0WMR3","name":"Loyal Customers"}],"cursor":"cursor-2"}`)
+	})
+
+	got, _, err := client.CustomerGroup.ListCustomerGroups(ctx, &ListOptions{Cursor: "cursor-1", Limit: 10})
+	if err != nil {
+		t.Fatalf("CustomerGroup.ListCustomerGroups returned error: %v", err)
+	}
+
+	expected := &ListCustomerGroups{
+		Groups: []CustomerGroupEntry{{Id: "2TAT3CMH4Q0A9M87XJZED0WMR3", Name: "Loyal Customers"}},
+		Cursor: "cursor-2",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("CustomerGroup.ListCustomerGroups returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCustomerGroupServiceOp_CreateCustomerGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	create := &CreateCustomerGroup{
+		IdempotencyKey: "e1b7a8f2-a1a8-4f0c-a3b4-4e0bc9b4b3f8",
+		Group:          &CustomerGroupEntry{Name: "Loyal Customers"},
+	}
+
+	mux.HandleFunc("/v2/customers/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(CreateCustomerGroup), create)
+		fmt.Fprint(w, customerGroupResponse)
+	})
+
+	got, _, err := client.CustomerGroup.CreateCustomerGroup(ctx, create)
+	if err != nil {
+		t.Fatalf("CustomerGroup.CreateCustomerGroup returned error: %v", err)
+	}
+	if expected := expectedCustomerGroup(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("CustomerGroup.CreateCustomerGroup returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCustomerGroupServiceOp_RetrieveCustomerGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/customers/groups/2TAT3CMH4Q0A9M87XJZED0WMR3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, customerGroupResponse)
+	})
+
+	got, _, err := client.CustomerGroup.RetrieveCustomerGroup(ctx, "2TAT3CMH4Q0A9M87XJZED0WMR3")
+	if err != nil {
+		t.Fatalf("CustomerGroup.RetrieveCustomerGroup returned error: %v", err)
+	}
+	if expected := expectedCustomerGroup(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("CustomerGroup.RetrieveCustomerGroup returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.CustomerGroup.RetrieveCustomerGroup(ctx, ""); err == nil {
+		t.Error("CustomerGroup.RetrieveCustomerGroup with an empty ID returned no error")
+	}
+}
+
+func TestCustomerGroupServiceOp_UpdateCustomerGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	group := &CustomerGroupEntry{Name: "Loyal Customers"}
+
+	mux.HandleFunc("/v2/customers/groups/2TAT3CMH4Q0A9M87XJZED0WMR3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testJSONBody(t, r, new(UpdateCustomerGroup), &UpdateCustomerGroup{Group: group})
+		fmt.Fprint(w, customerGroupResponse)
+	})
+
+	got, _, err := client.CustomerGroup.UpdateCustomerGroup(ctx, "2TAT3CMH4Q0A9M87XJZED0WMR3", group)
+	if err != nil {
+		t.Fatalf("CustomerGroup.UpdateCustomerGroup returned error: %v", err)
+	}
+	if expected := expectedCustomerGroup(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("CustomerGroup.UpdateCustomerGroup returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.CustomerGroup.UpdateCustomerGroup(ctx, "", group); err == nil {
+		t.Error("CustomerGroup.UpdateCustomerGroup with an empty ID returned no error")
+	}
+}
+
+func TestCustomerGroupServiceOp_DeleteCustomerGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var deleted bool
+	mux.HandleFunc("/v2/customers/groups/2TAT3CMH4Q0A9M87XJZED0WMR3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = true
+		fmt.Fprint(w, `{}`)
+	})
+
+	if _, err := client.CustomerGroup.DeleteCustomerGroup(ctx, "2TAT3CMH4Q0A9M87XJZED0WMR3"); err != nil {
+		t.Fatalf("CustomerGroup.DeleteCustomerGroup returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("CustomerGroup.DeleteCustomerGroup sent no request")
+	}
+
+	if _, err := client.CustomerGroup.DeleteCustomerGroup(ctx, ""); err == nil {
+		t.Error("CustomerGroup.DeleteCustomerGroup with an empty ID returned no error")
+	}
+}
+
+func TestCustomerGroupServiceOp_AddGroupToCustomer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	methods := make(map[string]int)
+	mux.HandleFunc("/v2/customers/JDKYHBWT1D4F8MFH63DBMEN8Y4/groups/2TAT3CMH4Q0A9M87XJZED0WMR3", func(w http.ResponseWriter, r *http.Request) {
+		methods[r.Method]++
+		fmt.Fprint(w, `{}`)
+	})
+
+	if _, err := client.CustomerGroup.AddGroupToCustomer(ctx, "JDKYHBWT1D4F8MFH63DBMEN8Y4", "2TAT3CMH4Q0A9M87XJZED0WMR3"); err != nil {
+		t.Fatalf("CustomerGroup.AddGroupToCustomer returned error: %v", err)
+	}
+	if _, err := client.CustomerGroup.RemoveGroupFromCustomer(ctx, "JDKYHBWT1D4F8MFH63DBMEN8Y4", "2TAT3CMH4Q0A9M87XJZED0WMR3"); err != nil {
+		t.Fatalf("CustomerGroup.RemoveGroupFromCustomer returned error: %v", err)
+	}
+
+	expected := map[string]int{http.MethodPut: 1, http.MethodDelete: 1}
+	if !reflect.DeepEqual(methods, expected) {
+		t.Errorf("CustomerGroup membership requests by method = %v, expected %v", methods, expected)
+	}
+
+	if _, err := client.CustomerGroup.AddGroupToCustomer(ctx, "", "2TAT3CMH4Q0A9M87XJZED0WMR3"); err == nil {
+		t.Error("CustomerGroup.AddGroupToCustomer with an empty customer ID returned no error")
+	}
+	if _, err := client.CustomerGroup.RemoveGroupFromCustomer(ctx, "JDKYHBWT1D4F8MFH63DBMEN8Y4", ""); err == nil {
+		t.Error("CustomerGroup.RemoveGroupFromCustomer with an empty group ID returned no error")
+	}
+}
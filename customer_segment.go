@@ -0,0 +1,82 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+// CustomerSegmentService is an interface for interfacing with the Square Customer Segments API.
+type CustomerSegmentService interface {
+	ListCustomerSegments(ctx context.Context, options *ListOptions) (*ListCustomerSegments, *Response, error)
+	RetrieveCustomerSegment(ctx context.Context, segmentId string) (*CustomerSegment, *Response, error)
+}
+
+var _ CustomerSegmentService = &CustomerSegmentServiceOp{}
+
+// CustomerSegmentServiceOp handles communication with the customer segment related methods of the Square API.
+type CustomerSegmentServiceOp struct {
+	client *Client
+}
+
+// ListCustomerSegments represents a list of customer segments.
+type ListCustomerSegments struct {
+	Segments []CustomerSegmentEntry `json:"segments"`
+	Cursor   string                 `json:"cursor,omitempty"`
+}
+
+// CustomerSegment represents a customer segment.
+type CustomerSegment struct {
+	Segment *CustomerSegmentEntry `json:"segment"`
+}
+
+// CustomerSegmentEntry represents a group of customers Square maintains from a filter, such as first-time
+// visitors. Segments are read only.
+type CustomerSegmentEntry struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ListCustomerSegments returns a list of the customer segments of the seller.
+func (s *CustomerSegmentServiceOp) ListCustomerSegments(ctx context.Context, options *ListOptions) (*ListCustomerSegments, *Response, error) {
+	p, err := addOptions(path.Join(CustomerBasePath, customerSegmentsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListCustomerSegments)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveCustomerSegment returns a customer segment by ID.
+func (s *CustomerSegmentServiceOp) RetrieveCustomerSegment(ctx context.Context, segmentId string) (*CustomerSegment, *Response, error) {
+	if len(segmentId) == 0 {
+		return nil, nil, NewArgError("segmentId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(CustomerBasePath, customerSegmentsPath, segmentId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(CustomerSegment)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
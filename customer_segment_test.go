@@ -0,0 +1,64 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCustomerSegmentServiceOp_ListCustomerSegments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/customers/segments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "cursor-1"})
+		fmt.Fprint(w, `{"segments":[{"id":"GMNXRZVEXNQDF.CHURN_RISK","name":"Lapsed","created_at":"2020-01-09T19:33:24.469Z","updated_at":"2020-04-13T21:47:04Z"}]}`)
+	})
+
+	got, _, err := client.CustomerSegment.ListCustomerSegments(ctx, &ListOptions{Cursor: "cursor-1"})
+	if err != nil {
+		t.Fatalf("CustomerSegment.ListCustomerSegments returned error: %v", err)
+	}
+
+	expected := &ListCustomerSegments{Segments: []CustomerSegmentEntry{{
+		Id:        "GMNXRZVEXNQDF.CHURN_RISK",
+		Name:      "Lapsed",
+		CreatedAt: time.Date(2020, 1, 9, 19, 33, 24, 469000000, time.UTC),
+		UpdatedAt: time.Date(2020, 4, 13, 21, 47, 4, 0, time.UTC),
+	}}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("CustomerSegment.ListCustomerSegments returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCustomerSegmentServiceOp_RetrieveCustomerSegment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/customers/segments/GMNXRZVEXNQDF.CHURN_RISK", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"segment":{"id":"GMNXRZVEXNQDF.CHURN_RISK","name":"Lapsed","created_at":"2020-01-09T19:33:24.469Z","updated_at":"2020-04-13T21:47:04Z"}}`)
+	})
+
+	got, _, err := client.CustomerSegment.RetrieveCustomerSegment(ctx, "GMNXRZVEXNQDF.CHURN_RISK")
+	if err != nil {
+		t.Fatalf("CustomerSegment.RetrieveCustomerSegment returned error: %v", err)
+	}
+
+	expected := &CustomerSegment{Segment: &CustomerSegmentEntry{
+		Id:        "GMNXRZVEXNQDF.CHURN_RISK",
+		Name:      "Lapsed",
+		CreatedAt: time.Date(2020, 1, 9, 19, 33, 24, 469000000, time.UTC),
+		UpdatedAt: time.Date(2020, 4, 13, 21, 47, 4, 0, time.UTC),
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("CustomerSegment.RetrieveCustomerSegment returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.CustomerSegment.RetrieveCustomerSegment(ctx, ""); err == nil {
+		t.Error("CustomerSegment.RetrieveCustomerSegment with an empty ID returned no error")
+	}
+}
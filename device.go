@@ -0,0 +1,161 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	DeviceBasePath = "v2/devices"
+)
+
+// Device status categories.
+const (
+	DeviceStatusAvailable      = "AVAILABLE"
+	DeviceStatusNeedsAttention = "NEEDS_ATTENTION"
+	DeviceStatusOffline        = "OFFLINE"
+)
+
+// DeviceService is an interface for interfacing with the Square Devices API.
+type DeviceService interface {
+	ListDevices(ctx context.Context, options *ListOptions) (*ListDevices, *Response, error)
+	GetDevice(ctx context.Context, deviceId string) (*Device, *Response, error)
+}
+
+var _ DeviceService = &DeviceServiceOp{}
+
+// DeviceServiceOp handles communication with the device related methods of the Square API.
+type DeviceServiceOp struct {
+	client *Client
+}
+
+// ListDevices represents a list of devices.
+type ListDevices struct {
+	Devices []DeviceEntry `json:"devices"`
+	Cursor  string        `json:"cursor,omitempty"`
+}
+
+// Device represents a device.
+type Device struct {
+	Device *DeviceEntry `json:"device"`
+}
+
+// DeviceEntry represents a Square device, such as a Square Terminal, and its components.
+type DeviceEntry struct {
+	Id         string            `json:"id"`
+	Attributes *DeviceAttributes `json:"attributes,omitempty"`
+	Components []DeviceComponent `json:"components,omitempty"`
+	Status     *DeviceStatus     `json:"status,omitempty"`
+}
+
+// DeviceAttributes represents the identity and software of a device.
+type DeviceAttributes struct {
+	Type            string     `json:"type"`
+	Manufacturer    string     `json:"manufacturer"`
+	Model           string     `json:"model,omitempty"`
+	Name            string     `json:"name,omitempty"`
+	ManufacturersId string     `json:"manufacturers_id,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+	Version         string     `json:"version,omitempty"`
+	MerchantToken   string     `json:"merchant_token,omitempty"`
+}
+
+// DeviceComponent represents a component of a device, such as its card reader or battery. The details of the
+// component are in the field matching its Type.
+type DeviceComponent struct {
+	Type               string             `json:"type"`
+	ApplicationDetails *DeviceApplication `json:"application_details,omitempty"`
+	CardReaderDetails  *DeviceCardReader  `json:"card_reader_details,omitempty"`
+	BatteryDetails     *DeviceBattery     `json:"battery_details,omitempty"`
+	WifiDetails        *DeviceWifi        `json:"wifi_details,omitempty"`
+	EthernetDetails    *DeviceEthernet    `json:"ethernet_details,omitempty"`
+}
+
+// DeviceApplication represents the Square application running on a device.
+type DeviceApplication struct {
+	ApplicationType string `json:"application_type,omitempty"`
+	Version         string `json:"version,omitempty"`
+	SessionLocation string `json:"session_location,omitempty"`
+	DeviceCodeId    string `json:"device_code_id,omitempty"`
+}
+
+// DeviceCardReader represents the card reader of a device.
+type DeviceCardReader struct {
+	Version string `json:"version,omitempty"`
+}
+
+// DeviceBattery represents the battery of a device.
+type DeviceBattery struct {
+	VisualPercent int    `json:"visual_percent,omitempty"`
+	ExternalPower string `json:"external_power,omitempty"`
+}
+
+// DeviceWifi represents the Wi-Fi connection of a device.
+type DeviceWifi struct {
+	Active           bool                  `json:"active,omitempty"`
+	Ssid             string                `json:"ssid,omitempty"`
+	IpAddressV4      string                `json:"ip_address_v4,omitempty"`
+	SecureConnection string                `json:"secure_connection,omitempty"`
+	SignalStrength   *DeviceSignalStrength `json:"signal_strength,omitempty"`
+}
+
+// DeviceSignalStrength represents the strength of a wireless signal, from 0 to 4.
+type DeviceSignalStrength struct {
+	Value int `json:"value"`
+}
+
+// DeviceEthernet represents the Ethernet connection of a device.
+type DeviceEthernet struct {
+	Active      bool   `json:"active,omitempty"`
+	IpAddressV4 string `json:"ip_address_v4,omitempty"`
+}
+
+// DeviceStatus represents the status of a device.
+type DeviceStatus struct {
+	Category string `json:"category"`
+}
+
+// ListDevices returns the devices of the merchant, optionally of a single location.
+func (s *DeviceServiceOp) ListDevices(ctx context.Context, options *ListOptions) (*ListDevices, *Response, error) {
+	p, err := addOptions(DeviceBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListDevices)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetDevice returns a device by ID. The cached status of a device may be stale; use WithoutCache to read it
+// fresh.
+func (s *DeviceServiceOp) GetDevice(ctx context.Context, deviceId string) (*Device, *Response, error) {
+	if len(deviceId) == 0 {
+		return nil, nil, NewArgError("deviceId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeDevice, deviceId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(DeviceBasePath, deviceId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Device)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,105 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var deviceResponse = `
+{
+  "device": {
+    "id": "device:995CS397A6475287",
+    "attributes": {
+      "type": "TERMINAL",
+      "manufacturer": "Square",
+      "model": "T2",
+      "name": "Square Terminal 995",
+      "manufacturers_id": "995CS397A6475287",
+      "version": "5.34.0"
+    },
+    "components": [
+      {"type": "BATTERY", "battery_details": {"visual_percent": 5, "external_power": "AVAILABLE_CHARGING"}}
+    ],
+    "status": {"category": "AVAILABLE"}
+  }
+}`
+
+func expectedDevice() *Device {
+	return &Device{Device: &DeviceEntry{
+		Id: "device:995CS397A6475287",
+		Attributes: &DeviceAttributes{
+			Type:            "TERMINAL",
+			Manufacturer:    "Square",
+			Model:           "T2",
+			Name:            "Square Terminal 995",
+			ManufacturersId: "995CS397A6475287",
+			Version:         "5.34.0",
+		},
+		Components: []DeviceComponent{{
+			Type:           "BATTERY",
+			BatteryDetails: &DeviceBattery{VisualPercent: 5, ExternalPower: "AVAILABLE_CHARGING"},
+		}},
+		Status: &DeviceStatus{Category: DeviceStatusAvailable},
+	}}
+}
+
+func TestDeviceServiceOp_ListDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/devices", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "cursor-1", "location_id": "18YC4JDH91E1H"})
+		fmt.Fprint(w, `{"devices":[{"id":"device:995CS397A6475287","status":{"category":"OFFLINE"}}],"cursor":"cursor-2"}`)
+	})
+
+	got, _, err := client.Device.ListDevices(ctx, &ListOptions{Cursor: "cursor-1", LocationID: "18YC4JDH91E1H"})
+	if err != nil {
+		t.Fatalf("Device.ListDevices returned error: %v", err)
+	}
+
+	expected := &ListDevices{
+		Devices: []DeviceEntry{{Id: "device:995CS397A6475287", Status: &DeviceStatus{Category: DeviceStatusOffline}}},
+		Cursor:  "cursor-2",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Device.ListDevices returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestDeviceServiceOp_GetDevice(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "DM7VKY8Q63GNP"}
+
+	var requests int
+	mux.HandleFunc("/v2/devices/device:995CS397A6475287", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		fmt.Fprint(w, deviceResponse)
+	})
+
+	for i := 0; i < 2; i++ {
+		got, _, err := client.Device.GetDevice(ctx, "device:995CS397A6475287")
+		if err != nil {
+			t.Fatalf("Device.GetDevice returned error: %v", err)
+		}
+		if expected := expectedDevice(); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Device.GetDevice returned %+v, expected %+v", got, expected)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Device.GetDevice made %d requests, expected 1", requests)
+	}
+
+	if _, _, err := client.Device.GetDevice(ctx, ""); err == nil {
+		t.Error("Device.GetDevice with an empty ID returned no error")
+	}
+}
@@ -0,0 +1,308 @@
+package squareup
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	DisputeBasePath = "v2/disputes"
+)
+
+// Dispute states.
+const (
+	DisputeStateInquiryEvidenceRequired    = "INQUIRY_EVIDENCE_REQUIRED"
+	DisputeStateInquiryProcessing          = "INQUIRY_PROCESSING"
+	DisputeStateInquiryClosed              = "INQUIRY_CLOSED"
+	DisputeStateEvidenceRequired           = "EVIDENCE_REQUIRED"
+	DisputeStateProcessing                 = "PROCESSING"
+	DisputeStateWon                        = "WON"
+	DisputeStateLost                       = "LOST"
+	DisputeStateAccepted                   = "ACCEPTED"
+	DisputeStateWaitingThirdParty          = "WAITING_THIRD_PARTY"
+	DisputeStateEvidenceRequiredThirdParty = "EVIDENCE_REQUIRED_THIRD_PARTY"
+)
+
+// DisputeService is an interface for interfacing with the Square Disputes API.
+type DisputeService interface {
+	ListDisputes(ctx context.Context, options *ListOptions) (*ListDisputes, *Response, error)
+	RetrieveDispute(ctx context.Context, disputeId string) (*Dispute, *Response, error)
+	AcceptDispute(ctx context.Context, disputeId string) (*Dispute, *Response, error)
+	SubmitEvidence(ctx context.Context, disputeId string) (*Dispute, *Response, error)
+	CreateDisputeEvidenceText(ctx context.Context, disputeId string, evidence *CreateDisputeEvidenceText) (*DisputeEvidence, *Response, error)
+	CreateDisputeEvidenceFile(ctx context.Context, disputeId string, evidence *CreateDisputeEvidenceFile, file *DisputeEvidenceUpload) (*DisputeEvidence, *Response, error)
+	ListDisputeEvidence(ctx context.Context, disputeId string, options *ListOptions) (*ListDisputeEvidence, *Response, error)
+	DeleteDisputeEvidence(ctx context.Context, disputeId, evidenceId string) (*Response, error)
+}
+
+var _ DisputeService = &DisputeServiceOp{}
+
+// DisputeServiceOp handles communication with the dispute related methods of the Square API.
+type DisputeServiceOp struct {
+	client *Client
+}
+
+// ListDisputes represents a list of disputes.
+type ListDisputes struct {
+	Disputes []DisputeEntry `json:"disputes"`
+	Cursor   string         `json:"cursor,omitempty"`
+}
+
+// Dispute represents a dispute.
+type Dispute struct {
+	Dispute *DisputeEntry `json:"dispute"`
+}
+
+// DisputeEntry represents a dispute entry.
+type DisputeEntry struct {
+	Id              string           `json:"id"`
+	AmountMoney     *AmountMoney     `json:"amount_money,omitempty"`
+	Reason          string           `json:"reason,omitempty"`
+	State           string           `json:"state,omitempty"`
+	DueAt           time.Time        `json:"due_at,omitempty"`
+	DisputedPayment *DisputedPayment `json:"disputed_payment,omitempty"`
+	CardBrand       string           `json:"card_brand,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+	BrandDisputeId  string           `json:"brand_dispute_id,omitempty"`
+	ReportedAt      time.Time        `json:"reported_at,omitempty"`
+	Version         int              `json:"version,omitempty"`
+	LocationId      string           `json:"location_id,omitempty"`
+}
+
+// DisputedPayment represents the payment a cardholder disputed.
+type DisputedPayment struct {
+	PaymentId string `json:"payment_id"`
+}
+
+// PaymentId returns the ID of the disputed payment, which can be passed to PaymentService.GetPayment.
+func (d *DisputeEntry) PaymentId() string {
+	if d.DisputedPayment == nil {
+		return ""
+	}
+	return d.DisputedPayment.PaymentId
+}
+
+// ListDisputeEvidence represents a list of dispute evidence.
+type ListDisputeEvidence struct {
+	Evidence []DisputeEvidenceEntry `json:"evidence"`
+	Cursor   string                 `json:"cursor,omitempty"`
+}
+
+// DisputeEvidence represents a piece of dispute evidence.
+type DisputeEvidence struct {
+	Evidence *DisputeEvidenceEntry `json:"evidence"`
+}
+
+// DisputeEvidenceEntry represents a dispute evidence entry.
+type DisputeEvidenceEntry struct {
+	Id           string               `json:"id"`
+	DisputeId    string               `json:"dispute_id"`
+	EvidenceFile *DisputeEvidenceFile `json:"evidence_file,omitempty"`
+	EvidenceText string               `json:"evidence_text,omitempty"`
+	UploadedAt   time.Time            `json:"uploaded_at,omitempty"`
+	EvidenceType string               `json:"evidence_type,omitempty"`
+}
+
+// DisputeEvidenceFile represents a file attached as dispute evidence.
+type DisputeEvidenceFile struct {
+	Filename string `json:"filename"`
+	Filetype string `json:"filetype"`
+}
+
+// CreateDisputeEvidenceText represents text evidence to be uploaded.
+type CreateDisputeEvidenceText struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	EvidenceType   string `json:"evidence_type,omitempty"`
+	EvidenceText   string `json:"evidence_text"`
+}
+
+// CreateDisputeEvidenceFile represents the metadata of file evidence to be uploaded.
+type CreateDisputeEvidenceFile struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	EvidenceType   string `json:"evidence_type,omitempty"`
+	ContentType    string `json:"content_type,omitempty"`
+}
+
+// DisputeEvidenceUpload represents the file content uploaded as dispute evidence. ContentType must be one of
+// image/heic, image/heif, image/jpeg, application/pdf, image/png or image/tiff.
+type DisputeEvidenceUpload struct {
+	Filename    string
+	ContentType string
+	Reader      io.Reader
+}
+
+// ListDisputes returns a list of disputes associated with the account making the request.
+func (s *DisputeServiceOp) ListDisputes(ctx context.Context, options *ListOptions) (*ListDisputes, *Response, error) {
+	p, err := addOptions(DisputeBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListDisputes)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveDispute returns a dispute by ID.
+func (s *DisputeServiceOp) RetrieveDispute(ctx context.Context, disputeId string) (*Dispute, *Response, error) {
+	if len(disputeId) == 0 {
+		return nil, nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+
+	p := path.Join(DisputeBasePath, disputeId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Dispute)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// AcceptDispute accepts the loss on a dispute. Square returns the disputed amount to the cardholder.
+func (s *DisputeServiceOp) AcceptDispute(ctx context.Context, disputeId string) (*Dispute, *Response, error) {
+	if len(disputeId) == 0 {
+		return nil, nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+
+	p := path.Join(DisputeBasePath, disputeId, "accept")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Dispute)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SubmitEvidence submits the uploaded evidence of a dispute to the bank.
+func (s *DisputeServiceOp) SubmitEvidence(ctx context.Context, disputeId string) (*Dispute, *Response, error) {
+	if len(disputeId) == 0 {
+		return nil, nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+
+	p := path.Join(DisputeBasePath, disputeId, "submit-evidence")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Dispute)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateDisputeEvidenceText uploads text to use as evidence for a dispute.
+func (s *DisputeServiceOp) CreateDisputeEvidenceText(ctx context.Context, disputeId string, evidence *CreateDisputeEvidenceText) (*DisputeEvidence, *Response, error) {
+	if len(disputeId) == 0 {
+		return nil, nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+
+	p := path.Join(DisputeBasePath, disputeId, "evidence-text")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, evidence)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(DisputeEvidence)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateDisputeEvidenceFile uploads a file to use as evidence for a dispute.
+func (s *DisputeServiceOp) CreateDisputeEvidenceFile(ctx context.Context, disputeId string, evidence *CreateDisputeEvidenceFile, file *DisputeEvidenceUpload) (*DisputeEvidence, *Response, error) {
+	if len(disputeId) == 0 {
+		return nil, nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+	if file == nil || file.Reader == nil {
+		return nil, nil, NewArgError("file", "cannot be nil")
+	}
+
+	p := path.Join(DisputeBasePath, disputeId, "evidence-files")
+	req, err := s.client.NewUploadRequest(ctx, p, evidence, "image_file", file.Filename, file.ContentType, file.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(DisputeEvidence)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListDisputeEvidence returns a list of evidence associated with a dispute.
+func (s *DisputeServiceOp) ListDisputeEvidence(ctx context.Context, disputeId string, options *ListOptions) (*ListDisputeEvidence, *Response, error) {
+	if len(disputeId) == 0 {
+		return nil, nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+
+	p, err := addOptions(path.Join(DisputeBasePath, disputeId, "evidence"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListDisputeEvidence)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteDisputeEvidence removes the specified evidence from a dispute.
+func (s *DisputeServiceOp) DeleteDisputeEvidence(ctx context.Context, disputeId, evidenceId string) (*Response, error) {
+	if len(disputeId) == 0 {
+		return nil, NewArgError("disputeId", "cannot be an empty string")
+	}
+	if len(evidenceId) == 0 {
+		return nil, NewArgError("evidenceId", "cannot be an empty string")
+	}
+
+	p := path.Join(DisputeBasePath, disputeId, "evidence", evidenceId)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
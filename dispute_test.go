@@ -0,0 +1,174 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	listDisputesResponse = `
+{
+  "disputes": [
+    {
+      "id": "XDgyFu7yo1E2S5lQGGpYn",
+      "amount_money": {
+        "amount": 2500,
+        "currency": "USD"
+      },
+      "reason": "NO_KNOWLEDGE",
+      "state": "EVIDENCE_REQUIRED",
+      "due_at": "2022-07-13T00:00:00.000Z",
+      "disputed_payment": {
+        "payment_id": "APgIq6RX2jM6DKDhMHiC6QEkuaB"
+      },
+      "card_brand": "VISA",
+      "created_at": "2022-06-29T18:45:22.265Z",
+      "updated_at": "2022-07-07T19:14:42.650Z",
+      "brand_dispute_id": "100000809947",
+      "version": 2,
+      "location_id": "L1HN3ZMQK64X9"
+    }
+  ],
+  "cursor": "G1aSTRm48CLjJsg6Sg3hQN1b1OMaoVuG"
+}`
+
+	disputeEvidenceResponse = `
+{
+  "evidence": {
+    "id": "TOomLInj6iWmP3N8qfCXrB",
+    "dispute_id": "bVTprrwk0gygTLZ96VX1oB",
+    "evidence_file": {
+      "filename": "customer-interaction.jpg",
+      "filetype": "image/jpeg"
+    },
+    "uploaded_at": "2022-05-18T16:01:10.000Z",
+    "evidence_type": "GENERIC_EVIDENCE"
+  }
+}`
+)
+
+func TestDisputeServiceOp_ListDisputes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/disputes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{
+			"states":      "EVIDENCE_REQUIRED,PROCESSING",
+			"location_id": "L1HN3ZMQK64X9",
+		})
+		fmt.Fprint(w, listDisputesResponse)
+	})
+
+	options := &ListOptions{
+		States:     []string{DisputeStateEvidenceRequired, DisputeStateProcessing},
+		LocationID: "L1HN3ZMQK64X9",
+	}
+	got, _, err := client.Dispute.ListDisputes(ctx, options)
+	if err != nil {
+		t.Fatalf("Dispute.ListDisputes returned error: %v", err)
+	}
+
+	expected := &ListDisputes{
+		Disputes: []DisputeEntry{
+			{
+				Id:              "XDgyFu7yo1E2S5lQGGpYn",
+				AmountMoney:     &AmountMoney{Amount: 2500, Currency: "USD"},
+				Reason:          "NO_KNOWLEDGE",
+				State:           DisputeStateEvidenceRequired,
+				DueAt:           time.Date(2022, 7, 13, 0, 0, 0, 0, time.UTC),
+				DisputedPayment: &DisputedPayment{PaymentId: "APgIq6RX2jM6DKDhMHiC6QEkuaB"},
+				CardBrand:       "VISA",
+				CreatedAt:       time.Date(2022, 6, 29, 18, 45, 22, 265000000, time.UTC),
+				UpdatedAt:       time.Date(2022, 7, 7, 19, 14, 42, 650000000, time.UTC),
+				BrandDisputeId:  "100000809947",
+				Version:         2,
+				LocationId:      "L1HN3ZMQK64X9",
+			},
+		},
+		Cursor: "G1aSTRm48CLjJsg6Sg3hQN1b1OMaoVuG",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Dispute.ListDisputes returned %+v, expected %+v", got, expected)
+	}
+
+	if id := got.Disputes[0].PaymentId(); id != "APgIq6RX2jM6DKDhMHiC6QEkuaB" {
+		t.Errorf("DisputeEntry.PaymentId() = %v, expected %v", id, "APgIq6RX2jM6DKDhMHiC6QEkuaB")
+	}
+}
+
+func TestDisputeServiceOp_CreateDisputeEvidenceFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	expectedRequest := &CreateDisputeEvidenceFile{
+		IdempotencyKey: "a0b9a6c1-9e1a-4a7b-b4b7-3e2cfc5d6f55",
+		EvidenceType:   "GENERIC_EVIDENCE",
+		ContentType:    "image/jpeg",
+	}
+
+	mux.HandleFunc("/v2/disputes/bVTprrwk0gygTLZ96VX1oB/evidence-files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm(): %v", err)
+		}
+
+		v := new(CreateDisputeEvidenceFile)
+		if err := json.Unmarshal([]byte(r.FormValue("request")), v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, expectedRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, expectedRequest)
+		}
+
+		f, h, err := r.FormFile("image_file")
+		if err != nil {
+			t.Fatalf("FormFile(): %v", err)
+		}
+		content, _ := io.ReadAll(f)
+		if string(content) != "jpeg-bytes" {
+			t.Errorf("File content = %q, expected %q", content, "jpeg-bytes")
+		}
+		if ct := h.Header.Get("Content-Type"); ct != "image/jpeg" {
+			t.Errorf("File Content-Type = %v, expected %v", ct, "image/jpeg")
+		}
+
+		fmt.Fprint(w, disputeEvidenceResponse)
+	})
+
+	file := &DisputeEvidenceUpload{
+		Filename:    "customer-interaction.jpg",
+		ContentType: "image/jpeg",
+		Reader:      strings.NewReader("jpeg-bytes"),
+	}
+	evidence := *expectedRequest
+	evidence.IdempotencyKey = "model-key"
+	keyCtx := WithRequestOptions(ctx, WithIdempotencyKey(expectedRequest.IdempotencyKey))
+	got, _, err := client.Dispute.CreateDisputeEvidenceFile(keyCtx, "bVTprrwk0gygTLZ96VX1oB", &evidence, file)
+	if err != nil {
+		t.Fatalf("Dispute.CreateDisputeEvidenceFile returned error: %v", err)
+	}
+
+	expected := &DisputeEvidence{
+		Evidence: &DisputeEvidenceEntry{
+			Id:        "TOomLInj6iWmP3N8qfCXrB",
+			DisputeId: "bVTprrwk0gygTLZ96VX1oB",
+			EvidenceFile: &DisputeEvidenceFile{
+				Filename: "customer-interaction.jpg",
+				Filetype: "image/jpeg",
+			},
+			UploadedAt:   time.Date(2022, 5, 18, 16, 1, 10, 0, time.UTC),
+			EvidenceType: "GENERIC_EVIDENCE",
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Dispute.CreateDisputeEvidenceFile returned %+v, expected %+v", got, expected)
+	}
+}
@@ -0,0 +1,130 @@
+package squareup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration which is sent to and received from Square as an ISO 8601 duration, e.g. PT5M.
+// Years and months have no fixed length and are not supported.
+type Duration time.Duration
+
+// Allowed ranges of the duration fields of request models.
+const (
+	MinPaymentDelayDuration = Duration(time.Minute)
+	MaxPaymentDelayDuration = Duration(7 * 24 * time.Hour)
+
+	MinTerminalDeadlineDuration = Duration(10 * time.Second)
+	MaxTerminalDeadlineDuration = Duration(5 * time.Minute)
+)
+
+// isoDurationRegexp matches the sign, weeks, days, hours, minutes and seconds of an ISO 8601 duration.
+var isoDurationRegexp = regexp.MustCompile(`^(-)?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseDuration parses an ISO 8601 duration such as PT5M or P1DT12H. A leading minus sign, as written by
+// Duration.String, makes the duration negative.
+func ParseDuration(s string) (Duration, error) {
+	m := isoDurationRegexp.FindStringSubmatch(s)
+	if m == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("squareup: invalid duration %q", s)
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute} {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+2], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("squareup: invalid duration %q: %v", s, err)
+		}
+		if n > int64(math.MaxInt64/unit) {
+			return 0, fmt.Errorf("squareup: invalid duration %q: out of range", s)
+		}
+		if d, err = addDuration(d, time.Duration(n)*unit); err != nil {
+			return 0, fmt.Errorf("squareup: invalid duration %q: %v", s, err)
+		}
+	}
+	if m[6] != "" {
+		seconds, err := time.ParseDuration(m[6] + "s")
+		if err != nil {
+			return 0, fmt.Errorf("squareup: invalid duration %q: %v", s, err)
+		}
+		if d, err = addDuration(d, seconds); err != nil {
+			return 0, fmt.Errorf("squareup: invalid duration %q: %v", s, err)
+		}
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+	return Duration(d), nil
+}
+
+// addDuration returns the sum of the non negative durations a and b, failing if it overflows.
+func addDuration(a, b time.Duration) (time.Duration, error) {
+	if a > math.MaxInt64-b {
+		return 0, errors.New("out of range")
+	}
+	return a + b, nil
+}
+
+// String returns d as an ISO 8601 duration in hours, minutes and seconds, e.g. PT168H.
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	td := time.Duration(d)
+	if td < 0 {
+		b.WriteByte('-')
+		td = -td
+	}
+	b.WriteString("PT")
+
+	if h := td / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dH", h)
+		td -= h * time.Hour
+	}
+	if m := td / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dM", m)
+		td -= m * time.Minute
+	}
+	if td > 0 {
+		b.WriteString(strconv.FormatFloat(td.Seconds(), 'f', -1, 64))
+		b.WriteByte('S')
+	}
+
+	return b.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Empty strings and null are read as zero.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*d = 0
+		return nil
+	}
+
+	v, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
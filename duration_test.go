@@ -0,0 +1,81 @@
+package squareup
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"PT0S":      0,
+		"PT5M":      5 * time.Minute,
+		"PT168H":    168 * time.Hour,
+		"P1W":       7 * 24 * time.Hour,
+		"P1DT12H":   36 * time.Hour,
+		"PT1M30.5S": 90*time.Second + 500*time.Millisecond,
+		"-PT5M":     -5 * time.Minute,
+		"-P1DT1S":   -(24*time.Hour + time.Second),
+	}
+
+	for s, expected := range tests {
+		d, err := ParseDuration(s)
+		if err != nil {
+			t.Errorf("ParseDuration(%q) returned error: %v", s, err)
+			continue
+		}
+		if time.Duration(d) != expected {
+			t.Errorf("ParseDuration(%q) = %v, expected %v", s, time.Duration(d), expected)
+		}
+	}
+
+	for _, s := range []string{
+		"", "P", "PT", "-P", "-PT", "5M", "P1Y", "P1M", "PT5m", "+PT5M",
+		"P99999999999999W", "PT9223372036854775807H", "PT2562047H48M", "P1DT9223372036S",
+	} {
+		if _, err := ParseDuration(s); err == nil {
+			t.Errorf("ParseDuration(%q) returned no error", s)
+		}
+	}
+}
+
+func TestDuration_String(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                                 "PT0S",
+		5 * time.Minute:                   "PT5M",
+		168 * time.Hour:                   "PT168H",
+		time.Hour + 90*time.Second:        "PT1H1M30S",
+		10*time.Second + time.Millisecond: "PT10.001S",
+	}
+
+	for d, expected := range tests {
+		if got := Duration(d).String(); got != expected {
+			t.Errorf("Duration(%v).String() = %q, expected %q", d, got, expected)
+		}
+	}
+
+	d := Duration(-(5*time.Minute + 30*time.Second))
+	if got, err := ParseDuration(d.String()); err != nil || got != d {
+		t.Errorf("ParseDuration(%q) = %v, %v, expected %v", d.String(), got, err, d)
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	refund := TerminalRefund{DeadlineDuration: Duration(5 * time.Minute)}
+	data, err := json.Marshal(refund)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	var got TerminalRefund
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if got.DeadlineDuration != refund.DeadlineDuration {
+		t.Errorf("DeadlineDuration = %v, expected %v", got.DeadlineDuration, refund.DeadlineDuration)
+	}
+
+	if err := json.Unmarshal([]byte(`{"deadline_duration":"soon"}`), &got); err == nil {
+		t.Error("json.Unmarshal of an invalid duration returned no error")
+	}
+}
@@ -22,3 +22,36 @@ func NewArgError(arg, reason string) *ArgError {
 func (e *ArgError) Error() string {
 	return fmt.Sprintf("%s is invalid because %s", e.arg, e.reason)
 }
+
+// Arg returns the name of the invalid argument, or the JSON path of the invalid field of a request model.
+func (e *ArgError) Arg() string {
+	return e.arg
+}
+
+// Reason returns why the argument is invalid.
+func (e *ArgError) Reason() string {
+	return e.reason
+}
+
+// Codes of the API errors worth handling specifically.
+const (
+	// ErrorCodeVersionMismatch is returned when the version token of a request is not the current version of the
+	// resource, which was changed since it was read.
+	ErrorCodeVersionMismatch = "VERSION_MISMATCH"
+)
+
+// APIError represents a single error returned by the Square API, either in an error response or alongside the
+// result of a bulk operation.
+type APIError struct {
+	Category string `json:"category"`
+	Code     string `json:"code"`
+	Detail   string `json:"detail,omitempty"`
+	Field    string `json:"field,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s (%s)", e.Code, e.Detail, e.Field)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Detail)
+}
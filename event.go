@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 type EventType string
@@ -19,6 +20,11 @@ const (
 )
 
 type EventData struct {
+	// Type is the type of the object contained in the event, e.g. bank_account.
+	Type string `json:"type"`
+	// Id is the ID of the object contained in the event.
+	Id string `json:"id"`
+
 	// Object is a raw mapping of the API resource contained in the event.
 	// Although marked with json:"-", it's still populated independently by
 	// a custom UnmarshalJSON implementation.
@@ -46,6 +52,26 @@ func (e *Event) GetPreviousValue(keys ...string) string {
 	return getValue(e.Data.PreviousAttributes, keys)
 }
 
+// BankAccount decodes the bank account of a bank_account.* event.
+func (e *Event) BankAccount() (*BankAccountEntry, error) {
+	root := new(BankAccount)
+	if err := e.decodeObject("bank_account.", root); err != nil {
+		return nil, err
+	}
+	return root.BankAccount, nil
+}
+
+// decodeObject decodes the object of the event into v, provided the event type starts with prefix.
+func (e *Event) decodeObject(prefix string, v interface{}) error {
+	if !strings.HasPrefix(string(e.Type), prefix) {
+		return fmt.Errorf("squareup: cannot decode %s event as %s*", e.Type, prefix)
+	}
+	if e.Data == nil || len(e.Data.Raw) == 0 {
+		return fmt.Errorf("squareup: %s event has no object", e.Type)
+	}
+	return json.Unmarshal(e.Data.Raw, v)
+}
+
 // UnmarshalJSON handles deserialization of the EventData.
 // This custom unmarshaling exists so that we can keep both the map and raw data.
 func (e *EventData) UnmarshalJSON(data []byte) error {
@@ -0,0 +1,73 @@
+package squareup
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+var (
+	bankAccountVerifiedEvent = `
+{
+  "merchant_id": "0HPGX5JYE6EE1",
+  "type": "bank_account.verified",
+  "event_id": "1ef1a1a8-9e84-4357-bb2a-6c0c8c5e0d84",
+  "data": {
+    "type": "bank_account",
+    "id": "ao6iaQ9vhDiaQD7n3GB",
+    "object": {
+      "bank_account": {
+        "id": "ao6iaQ9vhDiaQD7n3GB",
+        "account_number_suffix": "000",
+        "country": "US",
+        "currency": "USD",
+        "account_type": "CHECKING",
+        "holder_name": "Jane Doe",
+        "primary_bank_identification_number": "112200303",
+        "location_id": "S8GWD5R9QB376",
+        "status": "VERIFIED",
+        "creditable": true,
+        "debitable": true,
+        "version": 5,
+        "bank_name": "Bank Name"
+      }
+    }
+  }
+}`
+)
+
+func TestEvent_BankAccount(t *testing.T) {
+	e := new(Event)
+	if err := json.Unmarshal([]byte(bankAccountVerifiedEvent), e); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := e.BankAccount()
+	if err != nil {
+		t.Fatalf("Event.BankAccount returned error: %v", err)
+	}
+
+	expected := &BankAccountEntry{
+		Id:                              "ao6iaQ9vhDiaQD7n3GB",
+		AccountNumberSuffix:             "000",
+		Country:                         "US",
+		Currency:                        "USD",
+		AccountType:                     "CHECKING",
+		HolderName:                      "Jane Doe",
+		PrimaryBankIdentificationNumber: "112200303",
+		LocationId:                      "S8GWD5R9QB376",
+		Status:                          BankAccountStatusVerified,
+		Creditable:                      true,
+		Debitable:                       true,
+		Version:                         5,
+		BankName:                        "Bank Name",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Event.BankAccount returned %+v, expected %+v", got, expected)
+	}
+
+	e.Type = EventTypePaymentCreated
+	if _, err := e.BankAccount(); err == nil {
+		t.Error("Event.BankAccount of a payment event returned no error")
+	}
+}
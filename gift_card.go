@@ -0,0 +1,239 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	GiftCardBasePath = "v2/gift-cards"
+)
+
+// Gift card types, GAN sources and states.
+const (
+	GiftCardTypePhysical = "PHYSICAL"
+	GiftCardTypeDigital  = "DIGITAL"
+
+	GiftCardGANSourceSquare = "SQUARE"
+	GiftCardGANSourceOther  = "OTHER"
+
+	GiftCardStateNotActive   = "NOT_ACTIVE"
+	GiftCardStateActive      = "ACTIVE"
+	GiftCardStateDeactivated = "DEACTIVATED"
+	GiftCardStateBlocked     = "BLOCKED"
+	GiftCardStatePending     = "PENDING"
+)
+
+// GiftCardService is an interface for interfacing with the Square Gift Cards API.
+type GiftCardService interface {
+	ListGiftCards(ctx context.Context, options *ListOptions) (*ListGiftCards, *Response, error)
+	CreateGiftCard(ctx context.Context, giftCard *CreateGiftCard) (*GiftCard, *Response, error)
+	RetrieveGiftCard(ctx context.Context, giftCardId string) (*GiftCard, *Response, error)
+	RetrieveGiftCardFromGAN(ctx context.Context, gan string) (*GiftCard, *Response, error)
+	RetrieveGiftCardFromNonce(ctx context.Context, nonce string) (*GiftCard, *Response, error)
+	LinkCustomer(ctx context.Context, giftCardId, customerId string) (*GiftCard, *Response, error)
+	UnlinkCustomer(ctx context.Context, giftCardId, customerId string) (*GiftCard, *Response, error)
+}
+
+var _ GiftCardService = &GiftCardServiceOp{}
+
+// GiftCardServiceOp handles communication with the gift card related methods of the Square API.
+type GiftCardServiceOp struct {
+	client *Client
+}
+
+// ListGiftCards represents a list of gift cards.
+type ListGiftCards struct {
+	GiftCards []GiftCardEntry `json:"gift_cards"`
+	Cursor    string          `json:"cursor,omitempty"`
+}
+
+// GiftCard represents a gift card.
+type GiftCard struct {
+	GiftCard *GiftCardEntry `json:"gift_card"`
+}
+
+// GiftCardEntry represents a gift card entry.
+type GiftCardEntry struct {
+	Id           string       `json:"id,omitempty"`
+	Type         string       `json:"type"`
+	GanSource    string       `json:"gan_source,omitempty"`
+	State        string       `json:"state,omitempty"`
+	BalanceMoney *AmountMoney `json:"balance_money,omitempty"`
+	Gan          string       `json:"gan,omitempty"`
+	CreatedAt    *time.Time   `json:"created_at,omitempty"`
+	CustomerIds  []string     `json:"customer_ids,omitempty"`
+}
+
+// CreateGiftCard represents a gift card to be created. The card is created in the NOT_ACTIVE state and must be
+// activated with an ACTIVATE gift card activity.
+type CreateGiftCard struct {
+	IdempotencyKey string         `json:"idempotency_key"`
+	LocationId     string         `json:"location_id"`
+	GiftCard       *GiftCardEntry `json:"gift_card"`
+}
+
+// retrieveGiftCardFromGAN represents a gift card lookup by account number.
+type retrieveGiftCardFromGAN struct {
+	Gan string `json:"gan"`
+}
+
+// retrieveGiftCardFromNonce represents a gift card lookup by payment token.
+type retrieveGiftCardFromNonce struct {
+	Nonce string `json:"nonce"`
+}
+
+// giftCardCustomer represents a customer linked to or unlinked from a gift card.
+type giftCardCustomer struct {
+	CustomerId string `json:"customer_id"`
+}
+
+// ListGiftCards returns a list of gift cards, optionally filtered by type, state and customer.
+func (s *GiftCardServiceOp) ListGiftCards(ctx context.Context, options *ListOptions) (*ListGiftCards, *Response, error) {
+	p, err := addOptions(GiftCardBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListGiftCards)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateGiftCard creates a digital or physical gift card.
+func (s *GiftCardServiceOp) CreateGiftCard(ctx context.Context, giftCard *CreateGiftCard) (*GiftCard, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, GiftCardBasePath, giftCard)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCard)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveGiftCard returns a gift card by ID.
+func (s *GiftCardServiceOp) RetrieveGiftCard(ctx context.Context, giftCardId string) (*GiftCard, *Response, error) {
+	if len(giftCardId) == 0 {
+		return nil, nil, NewArgError("giftCardId", "cannot be an empty string")
+	}
+
+	p := path.Join(GiftCardBasePath, giftCardId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCard)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveGiftCardFromGAN returns a gift card by its gift card account number.
+func (s *GiftCardServiceOp) RetrieveGiftCardFromGAN(ctx context.Context, gan string) (*GiftCard, *Response, error) {
+	if len(gan) == 0 {
+		return nil, nil, NewArgError("gan", "cannot be an empty string")
+	}
+
+	p := path.Join(GiftCardBasePath, "from-gan")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, &retrieveGiftCardFromGAN{Gan: gan})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCard)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveGiftCardFromNonce returns a gift card by the payment token generated by the Web Payments SDK.
+func (s *GiftCardServiceOp) RetrieveGiftCardFromNonce(ctx context.Context, nonce string) (*GiftCard, *Response, error) {
+	if len(nonce) == 0 {
+		return nil, nil, NewArgError("nonce", "cannot be an empty string")
+	}
+
+	p := path.Join(GiftCardBasePath, "from-nonce")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, &retrieveGiftCardFromNonce{Nonce: nonce})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCard)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// LinkCustomer links a customer to a gift card.
+func (s *GiftCardServiceOp) LinkCustomer(ctx context.Context, giftCardId, customerId string) (*GiftCard, *Response, error) {
+	if len(giftCardId) == 0 {
+		return nil, nil, NewArgError("giftCardId", "cannot be an empty string")
+	}
+	if len(customerId) == 0 {
+		return nil, nil, NewArgError("customerId", "cannot be an empty string")
+	}
+
+	p := path.Join(GiftCardBasePath, giftCardId, "link-customer")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, &giftCardCustomer{CustomerId: customerId})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCard)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UnlinkCustomer unlinks a customer from a gift card.
+func (s *GiftCardServiceOp) UnlinkCustomer(ctx context.Context, giftCardId, customerId string) (*GiftCard, *Response, error) {
+	if len(giftCardId) == 0 {
+		return nil, nil, NewArgError("giftCardId", "cannot be an empty string")
+	}
+	if len(customerId) == 0 {
+		return nil, nil, NewArgError("customerId", "cannot be an empty string")
+	}
+
+	p := path.Join(GiftCardBasePath, giftCardId, "unlink-customer")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, &giftCardCustomer{CustomerId: customerId})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCard)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,193 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	giftCardActivityBasePath = GiftCardBasePath + "/activities"
+)
+
+// GiftCardActivityType is the type of a gift card activity.
+type GiftCardActivityType string
+
+const (
+	GiftCardActivityTypeActivate               GiftCardActivityType = "ACTIVATE"
+	GiftCardActivityTypeLoad                   GiftCardActivityType = "LOAD"
+	GiftCardActivityTypeRedeem                 GiftCardActivityType = "REDEEM"
+	GiftCardActivityTypeClearBalance           GiftCardActivityType = "CLEAR_BALANCE"
+	GiftCardActivityTypeDeactivate             GiftCardActivityType = "DEACTIVATE"
+	GiftCardActivityTypeAdjustIncrement        GiftCardActivityType = "ADJUST_INCREMENT"
+	GiftCardActivityTypeAdjustDecrement        GiftCardActivityType = "ADJUST_DECREMENT"
+	GiftCardActivityTypeRefund                 GiftCardActivityType = "REFUND"
+	GiftCardActivityTypeUnlinkedActivityRefund GiftCardActivityType = "UNLINKED_ACTIVITY_REFUND"
+	GiftCardActivityTypeImport                 GiftCardActivityType = "IMPORT"
+	GiftCardActivityTypeBlock                  GiftCardActivityType = "BLOCK"
+	GiftCardActivityTypeUnblock                GiftCardActivityType = "UNBLOCK"
+	GiftCardActivityTypeImportReversal         GiftCardActivityType = "IMPORT_REVERSAL"
+	GiftCardActivityTypeTransferBalanceFrom    GiftCardActivityType = "TRANSFER_BALANCE_FROM"
+	GiftCardActivityTypeTransferBalanceTo      GiftCardActivityType = "TRANSFER_BALANCE_TO"
+)
+
+// GiftCardActivityService is an interface for interfacing with the Square Gift Card Activities API.
+type GiftCardActivityService interface {
+	ListGiftCardActivities(ctx context.Context, options *ListOptions) (*ListGiftCardActivities, *Response, error)
+	CreateGiftCardActivity(ctx context.Context, activity *CreateGiftCardActivity) (*GiftCardActivity, *Response, error)
+}
+
+var _ GiftCardActivityService = &GiftCardActivityServiceOp{}
+
+// GiftCardActivityServiceOp handles communication with the gift card activity related methods of the Square API.
+type GiftCardActivityServiceOp struct {
+	client *Client
+}
+
+// ListGiftCardActivities represents a list of gift card activities.
+type ListGiftCardActivities struct {
+	GiftCardActivities []GiftCardActivityEntry `json:"gift_card_activities"`
+	Cursor             string                  `json:"cursor,omitempty"`
+}
+
+// GiftCardActivity represents a gift card activity.
+type GiftCardActivity struct {
+	GiftCardActivity *GiftCardActivityEntry `json:"gift_card_activity"`
+}
+
+// GiftCardActivityEntry represents an action performed on a gift card. Exactly one of the details fields matching
+// Type is set.
+type GiftCardActivityEntry struct {
+	Id                   string               `json:"id,omitempty"`
+	Type                 GiftCardActivityType `json:"type"`
+	LocationId           string               `json:"location_id"`
+	CreatedAt            *time.Time           `json:"created_at,omitempty"`
+	GiftCardId           string               `json:"gift_card_id,omitempty"`
+	GiftCardGan          string               `json:"gift_card_gan,omitempty"`
+	GiftCardBalanceMoney *AmountMoney         `json:"gift_card_balance_money,omitempty"`
+
+	ActivateActivityDetails        *GiftCardActivityActivate        `json:"activate_activity_details,omitempty"`
+	LoadActivityDetails            *GiftCardActivityLoad            `json:"load_activity_details,omitempty"`
+	RedeemActivityDetails          *GiftCardActivityRedeem          `json:"redeem_activity_details,omitempty"`
+	ClearBalanceActivityDetails    *GiftCardActivityReason          `json:"clear_balance_activity_details,omitempty"`
+	DeactivateActivityDetails      *GiftCardActivityReason          `json:"deactivate_activity_details,omitempty"`
+	AdjustIncrementActivityDetails *GiftCardActivityAdjust          `json:"adjust_increment_activity_details,omitempty"`
+	AdjustDecrementActivityDetails *GiftCardActivityAdjust          `json:"adjust_decrement_activity_details,omitempty"`
+	RefundActivityDetails          *GiftCardActivityRefund          `json:"refund_activity_details,omitempty"`
+	BlockActivityDetails           *GiftCardActivityReason          `json:"block_activity_details,omitempty"`
+	UnblockActivityDetails         *GiftCardActivityReason          `json:"unblock_activity_details,omitempty"`
+	ImportActivityDetails          *GiftCardActivityImport          `json:"import_activity_details,omitempty"`
+	ImportReversalActivityDetails  *GiftCardActivityImport          `json:"import_reversal_activity_details,omitempty"`
+	UnlinkedActivityRefundDetails  *GiftCardActivityUnlinkedRefund  `json:"unlinked_activity_refund_activity_details,omitempty"`
+	TransferBalanceFromDetails     *GiftCardActivityTransferBalance `json:"transfer_balance_from_activity_details,omitempty"`
+	TransferBalanceToDetails       *GiftCardActivityTransferBalance `json:"transfer_balance_to_activity_details,omitempty"`
+}
+
+// GiftCardActivityActivate represents the details of an ACTIVATE activity. Either AmountMoney or OrderId with
+// LineItemUid must be set.
+type GiftCardActivityActivate struct {
+	AmountMoney               *AmountMoney `json:"amount_money,omitempty"`
+	OrderId                   string       `json:"order_id,omitempty"`
+	LineItemUid               string       `json:"line_item_uid,omitempty"`
+	ReferenceId               string       `json:"reference_id,omitempty"`
+	BuyerPaymentInstrumentIds []string     `json:"buyer_payment_instrument_ids,omitempty"`
+}
+
+// GiftCardActivityLoad represents the details of a LOAD activity.
+type GiftCardActivityLoad struct {
+	AmountMoney               *AmountMoney `json:"amount_money,omitempty"`
+	OrderId                   string       `json:"order_id,omitempty"`
+	LineItemUid               string       `json:"line_item_uid,omitempty"`
+	ReferenceId               string       `json:"reference_id,omitempty"`
+	BuyerPaymentInstrumentIds []string     `json:"buyer_payment_instrument_ids,omitempty"`
+}
+
+// GiftCardActivityRedeem represents the details of a REDEEM activity.
+type GiftCardActivityRedeem struct {
+	AmountMoney *AmountMoney `json:"amount_money"`
+	PaymentId   string       `json:"payment_id,omitempty"`
+	ReferenceId string       `json:"reference_id,omitempty"`
+	Status      string       `json:"status,omitempty"`
+}
+
+// GiftCardActivityAdjust represents the details of an ADJUST_INCREMENT or ADJUST_DECREMENT activity.
+type GiftCardActivityAdjust struct {
+	AmountMoney *AmountMoney `json:"amount_money"`
+	Reason      string       `json:"reason"`
+}
+
+// GiftCardActivityReason represents the details of activities that only carry a reason, such as DEACTIVATE.
+type GiftCardActivityReason struct {
+	Reason string `json:"reason"`
+}
+
+// GiftCardActivityRefund represents the details of a REFUND activity.
+type GiftCardActivityRefund struct {
+	RedeemActivityId string       `json:"redeem_activity_id,omitempty"`
+	AmountMoney      *AmountMoney `json:"amount_money,omitempty"`
+	ReferenceId      string       `json:"reference_id,omitempty"`
+	PaymentId        string       `json:"payment_id,omitempty"`
+}
+
+// GiftCardActivityImport represents the details of an IMPORT or IMPORT_REVERSAL activity.
+type GiftCardActivityImport struct {
+	AmountMoney *AmountMoney `json:"amount_money"`
+}
+
+// GiftCardActivityUnlinkedRefund represents the details of an UNLINKED_ACTIVITY_REFUND activity.
+type GiftCardActivityUnlinkedRefund struct {
+	AmountMoney *AmountMoney `json:"amount_money"`
+	ReferenceId string       `json:"reference_id,omitempty"`
+	PaymentId   string       `json:"payment_id,omitempty"`
+}
+
+// GiftCardActivityTransferBalance represents the details of a balance transfer between gift cards.
+type GiftCardActivityTransferBalance struct {
+	TransferFromGiftCardId string       `json:"transfer_from_gift_card_id,omitempty"`
+	TransferToGiftCardId   string       `json:"transfer_to_gift_card_id,omitempty"`
+	AmountMoney            *AmountMoney `json:"amount_money"`
+}
+
+// CreateGiftCardActivity represents a gift card activity to be created.
+type CreateGiftCardActivity struct {
+	IdempotencyKey   string                 `json:"idempotency_key"`
+	GiftCardActivity *GiftCardActivityEntry `json:"gift_card_activity"`
+}
+
+// ListGiftCardActivities returns a list of gift card activities, optionally filtered by gift card, type,
+// location and time range.
+func (s *GiftCardActivityServiceOp) ListGiftCardActivities(ctx context.Context, options *ListOptions) (*ListGiftCardActivities, *Response, error) {
+	p, err := addOptions(giftCardActivityBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListGiftCardActivities)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateGiftCardActivity creates a gift card activity, such as activating, loading or redeeming a gift card.
+func (s *GiftCardActivityServiceOp) CreateGiftCardActivity(ctx context.Context, activity *CreateGiftCardActivity) (*GiftCardActivity, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, giftCardActivityBasePath, activity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(GiftCardActivity)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,369 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	giftCardResponse = `
+{
+  "gift_card": {
+    "id": "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+    "type": "DIGITAL",
+    "gan_source": "SQUARE",
+    "state": "ACTIVE",
+    "balance_money": {
+      "amount": 2500,
+      "currency": "USD"
+    },
+    "gan": "7783320006753271",
+    "created_at": "2021-05-20T22:26:54.000Z",
+    "customer_ids": [
+      "GKY0FZ3V717AH8Q2D821PNT2ZW"
+    ]
+  }
+}`
+
+	giftCardActivityResponse = `
+{
+  "gift_card_activity": {
+    "id": "gcact_c8f8cbf1f24b448d8ecf39ed03f97864",
+    "type": "ACTIVATE",
+    "location_id": "81FN9BNFZTKS4",
+    "created_at": "2021-05-20T22:26:54.000Z",
+    "gift_card_id": "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+    "gift_card_gan": "7783320006753271",
+    "gift_card_balance_money": {
+      "amount": 1000,
+      "currency": "USD"
+    },
+    "activate_activity_details": {
+      "amount_money": {
+        "amount": 1000,
+        "currency": "USD"
+      },
+      "reference_id": "client-side-id"
+    }
+  }
+}`
+)
+
+func expectedGiftCard() *GiftCard {
+	createdAt := time.Date(2021, 5, 20, 22, 26, 54, 0, time.UTC)
+	return &GiftCard{
+		GiftCard: &GiftCardEntry{
+			Id:           "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+			Type:         GiftCardTypeDigital,
+			GanSource:    GiftCardGANSourceSquare,
+			State:        GiftCardStateActive,
+			BalanceMoney: &AmountMoney{Amount: 2500, Currency: "USD"},
+			Gan:          "7783320006753271",
+			CreatedAt:    &createdAt,
+			CustomerIds:  []string{"GKY0FZ3V717AH8Q2D821PNT2ZW"},
+		},
+	}
+}
+
+func TestGiftCardServiceOp_ListGiftCards(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/gift-cards", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{
+			"type":        "DIGITAL",
+			"state":       "ACTIVE",
+			"customer_id": "GKY0FZ3V717AH8Q2D821PNT2ZW",
+		})
+		fmt.Fprint(w, `{"gift_cards":[{"id":"gftc:6d55a72470d940c6ba09c0ab8ad08d20","type":"DIGITAL"}],"cursor":"NEXT"}`)
+	})
+
+	options := &ListOptions{
+		Type:       GiftCardTypeDigital,
+		State:      GiftCardStateActive,
+		CustomerID: "GKY0FZ3V717AH8Q2D821PNT2ZW",
+	}
+	got, _, err := client.GiftCard.ListGiftCards(ctx, options)
+	if err != nil {
+		t.Fatalf("GiftCard.ListGiftCards returned error: %v", err)
+	}
+
+	expected := &ListGiftCards{
+		GiftCards: []GiftCardEntry{{Id: "gftc:6d55a72470d940c6ba09c0ab8ad08d20", Type: GiftCardTypeDigital}},
+		Cursor:    "NEXT",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCard.ListGiftCards returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestGiftCardServiceOp_CreateGiftCard(t *testing.T) {
+	setup()
+	defer teardown()
+
+	giftCard := &CreateGiftCard{
+		IdempotencyKey: "NC9Tm69EjbjtConu",
+		LocationId:     "81FN9BNFZTKS4",
+		GiftCard:       &GiftCardEntry{Type: GiftCardTypeDigital},
+	}
+
+	mux.HandleFunc("/v2/gift-cards", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(CreateGiftCard)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, giftCard) {
+			t.Errorf("Request body = %+v, expected %+v", v, giftCard)
+		}
+
+		fmt.Fprint(w, giftCardResponse)
+	})
+
+	got, _, err := client.GiftCard.CreateGiftCard(ctx, giftCard)
+	if err != nil {
+		t.Fatalf("GiftCard.CreateGiftCard returned error: %v", err)
+	}
+	if expected := expectedGiftCard(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCard.CreateGiftCard returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestGiftCardServiceOp_RetrieveGiftCard(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/gift-cards/gftc:6d55a72470d940c6ba09c0ab8ad08d20", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, giftCardResponse)
+	})
+
+	got, _, err := client.GiftCard.RetrieveGiftCard(ctx, "gftc:6d55a72470d940c6ba09c0ab8ad08d20")
+	if err != nil {
+		t.Fatalf("GiftCard.RetrieveGiftCard returned error: %v", err)
+	}
+	if expected := expectedGiftCard(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCard.RetrieveGiftCard returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.GiftCard.RetrieveGiftCard(ctx, ""); err == nil {
+		t.Error("GiftCard.RetrieveGiftCard with an empty ID returned no error")
+	}
+}
+
+func TestGiftCardServiceOp_RetrieveGiftCardFrom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	lookups := map[string]string{
+		"/v2/gift-cards/from-gan":   `{"gan":"7783320006753271"}`,
+		"/v2/gift-cards/from-nonce": `{"nonce":"cnon:7783322135245171"}`,
+	}
+	for p, body := range lookups {
+		body := body
+		mux.HandleFunc(p, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			if got := strings.TrimSpace(StreamToString(r.Body)); got != body {
+				t.Errorf("Request body = %s, expected %s", got, body)
+			}
+			fmt.Fprint(w, giftCardResponse)
+		})
+	}
+
+	got, _, err := client.GiftCard.RetrieveGiftCardFromGAN(ctx, "7783320006753271")
+	if err != nil {
+		t.Fatalf("GiftCard.RetrieveGiftCardFromGAN returned error: %v", err)
+	}
+	if expected := expectedGiftCard(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCard.RetrieveGiftCardFromGAN returned %+v, expected %+v", got, expected)
+	}
+
+	got, _, err = client.GiftCard.RetrieveGiftCardFromNonce(ctx, "cnon:7783322135245171")
+	if err != nil {
+		t.Fatalf("GiftCard.RetrieveGiftCardFromNonce returned error: %v", err)
+	}
+	if expected := expectedGiftCard(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCard.RetrieveGiftCardFromNonce returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.GiftCard.RetrieveGiftCardFromGAN(ctx, ""); err == nil {
+		t.Error("GiftCard.RetrieveGiftCardFromGAN with an empty GAN returned no error")
+	}
+	if _, _, err := client.GiftCard.RetrieveGiftCardFromNonce(ctx, ""); err == nil {
+		t.Error("GiftCard.RetrieveGiftCardFromNonce with an empty nonce returned no error")
+	}
+}
+
+func TestGiftCardServiceOp_LinkCustomer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, action := range []string{"link-customer", "unlink-customer"} {
+		mux.HandleFunc("/v2/gift-cards/gftc:6d55a72470d940c6ba09c0ab8ad08d20/"+action, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			expected := `{"customer_id":"GKY0FZ3V717AH8Q2D821PNT2ZW"}`
+			if got := strings.TrimSpace(StreamToString(r.Body)); got != expected {
+				t.Errorf("Request body = %s, expected %s", got, expected)
+			}
+			fmt.Fprint(w, giftCardResponse)
+		})
+	}
+
+	got, _, err := client.GiftCard.LinkCustomer(ctx, "gftc:6d55a72470d940c6ba09c0ab8ad08d20", "GKY0FZ3V717AH8Q2D821PNT2ZW")
+	if err != nil {
+		t.Fatalf("GiftCard.LinkCustomer returned error: %v", err)
+	}
+	if expected := expectedGiftCard(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCard.LinkCustomer returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.GiftCard.UnlinkCustomer(ctx, "gftc:6d55a72470d940c6ba09c0ab8ad08d20", "GKY0FZ3V717AH8Q2D821PNT2ZW"); err != nil {
+		t.Fatalf("GiftCard.UnlinkCustomer returned error: %v", err)
+	}
+
+	if _, _, err := client.GiftCard.LinkCustomer(ctx, "", "GKY0FZ3V717AH8Q2D821PNT2ZW"); err == nil {
+		t.Error("GiftCard.LinkCustomer with an empty gift card ID returned no error")
+	}
+	if _, _, err := client.GiftCard.UnlinkCustomer(ctx, "gftc:6d55a72470d940c6ba09c0ab8ad08d20", ""); err == nil {
+		t.Error("GiftCard.UnlinkCustomer with an empty customer ID returned no error")
+	}
+}
+
+func TestGiftCardActivityServiceOp_ListGiftCardActivities(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/gift-cards/activities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{
+			"gift_card_id": "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+			"type":         "REDEEM",
+		})
+		fmt.Fprint(w, `{"gift_card_activities":[{"id":"gcact_1","type":"REDEEM","location_id":"81FN9BNFZTKS4","redeem_activity_details":{"amount_money":{"amount":500,"currency":"USD"},"status":"COMPLETED"}}]}`)
+	})
+
+	options := &ListOptions{
+		GiftCardID: "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+		Type:       string(GiftCardActivityTypeRedeem),
+	}
+	got, _, err := client.GiftCardActivity.ListGiftCardActivities(ctx, options)
+	if err != nil {
+		t.Fatalf("GiftCardActivity.ListGiftCardActivities returned error: %v", err)
+	}
+
+	expected := &ListGiftCardActivities{
+		GiftCardActivities: []GiftCardActivityEntry{
+			{
+				Id:         "gcact_1",
+				Type:       GiftCardActivityTypeRedeem,
+				LocationId: "81FN9BNFZTKS4",
+				RedeemActivityDetails: &GiftCardActivityRedeem{
+					AmountMoney: &AmountMoney{Amount: 500, Currency: "USD"},
+					Status:      "COMPLETED",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCardActivity.ListGiftCardActivities returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestGiftCardActivityServiceOp_CreateGiftCardActivity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	activity := &CreateGiftCardActivity{
+		IdempotencyKey: "U16kfr-kA70er-q4Rsym-7U7NnY",
+		GiftCardActivity: &GiftCardActivityEntry{
+			Type:       GiftCardActivityTypeActivate,
+			LocationId: "81FN9BNFZTKS4",
+			GiftCardId: "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+			ActivateActivityDetails: &GiftCardActivityActivate{
+				AmountMoney: &AmountMoney{Amount: 1000, Currency: "USD"},
+				ReferenceId: "client-side-id",
+			},
+		},
+	}
+
+	mux.HandleFunc("/v2/gift-cards/activities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(CreateGiftCardActivity)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, activity) {
+			t.Errorf("Request body = %+v, expected %+v", v, activity)
+		}
+
+		fmt.Fprint(w, giftCardActivityResponse)
+	})
+
+	got, _, err := client.GiftCardActivity.CreateGiftCardActivity(ctx, activity)
+	if err != nil {
+		t.Fatalf("GiftCardActivity.CreateGiftCardActivity returned error: %v", err)
+	}
+
+	createdAt := time.Date(2021, 5, 20, 22, 26, 54, 0, time.UTC)
+	expected := &GiftCardActivity{
+		GiftCardActivity: &GiftCardActivityEntry{
+			Id:                   "gcact_c8f8cbf1f24b448d8ecf39ed03f97864",
+			Type:                 GiftCardActivityTypeActivate,
+			LocationId:           "81FN9BNFZTKS4",
+			CreatedAt:            &createdAt,
+			GiftCardId:           "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+			GiftCardGan:          "7783320006753271",
+			GiftCardBalanceMoney: &AmountMoney{Amount: 1000, Currency: "USD"},
+			ActivateActivityDetails: &GiftCardActivityActivate{
+				AmountMoney: &AmountMoney{Amount: 1000, Currency: "USD"},
+				ReferenceId: "client-side-id",
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("GiftCardActivity.CreateGiftCardActivity returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestPaymentServiceOp_CreatePayment_giftCard(t *testing.T) {
+	setup()
+	defer teardown()
+
+	payment := &CreatePayment{
+		IdempotencyKey: "4935a656-a929-4792-b97c-8848be85c27c",
+		SourceId:       "gftc:6d55a72470d940c6ba09c0ab8ad08d20",
+		AmountMoney:    &AmountMoney{Amount: 500, Currency: "USD"},
+	}
+
+	mux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(CreatePayment)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if v.SourceId != payment.SourceId {
+			t.Errorf("Request source_id = %v, expected %v", v.SourceId, payment.SourceId)
+		}
+
+		fmt.Fprint(w, `{"payment":{"id":"GQTFp1ZlXdpoW4o6eGiZhbjosiDFf","status":"COMPLETED","source_type":"CARD","card_details":{"status":"CAPTURED","card":{"card_brand":"SQUARE_GIFT_CARD","last_4":"3271","fingerprint":"sq-1-gift"},"entry_method":"KEYED"}}}`)
+	})
+
+	got, _, err := client.Payment.CreatePayment(ctx, payment)
+	if err != nil {
+		t.Fatalf("Payment.CreatePayment returned error: %v", err)
+	}
+
+	expected := &PaymentGiftCardDetails{Last4: "3271", Fingerprint: "sq-1-gift", Status: "CAPTURED", EntryMethod: "KEYED"}
+	if details := got.Payment.GiftCardDetails(); !reflect.DeepEqual(details, expected) {
+		t.Errorf("PaymentEntry.GiftCardDetails() = %+v, expected %+v", details, expected)
+	}
+}
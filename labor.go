@@ -0,0 +1,432 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	LaborBasePath = "v2/labor"
+
+	laborBreakTypesPath      = "break-types"
+	laborShiftsPath          = "shifts"
+	laborWorkweekConfigsPath = "workweek-configs"
+)
+
+// Shift statuses.
+const (
+	ShiftStatusOpen   = "OPEN"
+	ShiftStatusClosed = "CLOSED"
+)
+
+// LaborService is an interface for interfacing with the Square Labor API.
+type LaborService interface {
+	ListBreakTypes(ctx context.Context, options *ListOptions) (*ListBreakTypes, *Response, error)
+	CreateBreakType(ctx context.Context, breakType *CreateBreakType) (*BreakType, *Response, error)
+	GetBreakType(ctx context.Context, breakTypeId string) (*BreakType, *Response, error)
+	UpdateBreakType(ctx context.Context, breakTypeId string, breakType *BreakTypeEntry) (*BreakType, *Response, error)
+	DeleteBreakType(ctx context.Context, breakTypeId string) (*Response, error)
+
+	CreateShift(ctx context.Context, shift *CreateShift) (*Shift, *Response, error)
+	SearchShifts(ctx context.Context, search *SearchShifts) (*ListShifts, *Response, error)
+	GetShift(ctx context.Context, shiftId string) (*Shift, *Response, error)
+	UpdateShift(ctx context.Context, shiftId string, shift *ShiftEntry) (*Shift, *Response, error)
+	DeleteShift(ctx context.Context, shiftId string) (*Response, error)
+
+	ListWorkweekConfigs(ctx context.Context, options *ListOptions) (*ListWorkweekConfigs, *Response, error)
+	UpdateWorkweekConfig(ctx context.Context, configId string, config *WorkweekConfigEntry) (*WorkweekConfig, *Response, error)
+}
+
+var _ LaborService = &LaborServiceOp{}
+
+// LaborServiceOp handles communication with the labor related methods of the Square API.
+type LaborServiceOp struct {
+	client *Client
+}
+
+// ListBreakTypes represents a list of break types.
+type ListBreakTypes struct {
+	BreakTypes []BreakTypeEntry `json:"break_types"`
+	Cursor     string           `json:"cursor,omitempty"`
+}
+
+// BreakType represents a break type.
+type BreakType struct {
+	BreakType *BreakTypeEntry `json:"break_type"`
+}
+
+// BreakTypeEntry represents a kind of break team members can take during a shift at a location.
+type BreakTypeEntry struct {
+	Id               string     `json:"id,omitempty"`
+	LocationId       string     `json:"location_id"`
+	BreakName        string     `json:"break_name"`
+	ExpectedDuration string     `json:"expected_duration"`
+	IsPaid           bool       `json:"is_paid"`
+	Version          int        `json:"version,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+// CreateBreakType represents a break type to be created.
+type CreateBreakType struct {
+	IdempotencyKey string          `json:"idempotency_key,omitempty"`
+	BreakType      *BreakTypeEntry `json:"break_type"`
+}
+
+// ListShifts represents a list of shifts.
+type ListShifts struct {
+	Shifts []ShiftEntry `json:"shifts"`
+	Cursor string       `json:"cursor,omitempty"`
+}
+
+// Shift represents a shift.
+type Shift struct {
+	Shift *ShiftEntry `json:"shift"`
+}
+
+// ShiftEntry represents a single period of work of a team member at a location.
+type ShiftEntry struct {
+	Id                   string       `json:"id,omitempty"`
+	LocationId           string       `json:"location_id"`
+	Timezone             string       `json:"timezone,omitempty"`
+	StartAt              time.Time    `json:"start_at"`
+	EndAt                *time.Time   `json:"end_at,omitempty"`
+	Wage                 *ShiftWage   `json:"wage,omitempty"`
+	Breaks               []ShiftBreak `json:"breaks,omitempty"`
+	Status               string       `json:"status,omitempty"`
+	Version              int          `json:"version,omitempty"`
+	CreatedAt            *time.Time   `json:"created_at,omitempty"`
+	UpdatedAt            *time.Time   `json:"updated_at,omitempty"`
+	TeamMemberId         string       `json:"team_member_id,omitempty"`
+	DeclaredCashTipMoney *AmountMoney `json:"declared_cash_tip_money,omitempty"`
+}
+
+// ShiftWage represents the job and hourly rate a shift is paid at.
+type ShiftWage struct {
+	Title       string       `json:"title,omitempty"`
+	HourlyRate  *AmountMoney `json:"hourly_rate,omitempty"`
+	JobId       string       `json:"job_id,omitempty"`
+	TipEligible bool         `json:"tip_eligible,omitempty"`
+}
+
+// ShiftBreak represents a break taken during a shift.
+type ShiftBreak struct {
+	Id               string     `json:"id,omitempty"`
+	StartAt          time.Time  `json:"start_at"`
+	EndAt            *time.Time `json:"end_at,omitempty"`
+	BreakTypeId      string     `json:"break_type_id"`
+	Name             string     `json:"name"`
+	ExpectedDuration string     `json:"expected_duration"`
+	IsPaid           bool       `json:"is_paid"`
+}
+
+// CreateShift represents a shift to be created.
+type CreateShift struct {
+	IdempotencyKey string      `json:"idempotency_key,omitempty"`
+	Shift          *ShiftEntry `json:"shift"`
+}
+
+// SearchShifts represents a shift search.
+type SearchShifts struct {
+	Query  *ShiftQuery `json:"query,omitempty"`
+	Limit  int         `json:"limit,omitempty"`
+	Cursor string      `json:"cursor,omitempty"`
+}
+
+// ShiftQuery represents the filters and sort order of a shift search.
+type ShiftQuery struct {
+	Filter *ShiftFilter `json:"filter,omitempty"`
+	Sort   *struct {
+		Field string `json:"field,omitempty"`
+		Order string `json:"order,omitempty"`
+	} `json:"sort,omitempty"`
+}
+
+// ShiftFilter represents the filters of a shift search.
+type ShiftFilter struct {
+	LocationIds   []string      `json:"location_ids,omitempty"`
+	TeamMemberIds []string      `json:"team_member_ids,omitempty"`
+	Status        string        `json:"status,omitempty"`
+	Start         *TimeRange    `json:"start,omitempty"`
+	End           *TimeRange    `json:"end,omitempty"`
+	Workday       *ShiftWorkday `json:"workday,omitempty"`
+}
+
+// TimeRange represents a range of time. Either bound may be omitted.
+type TimeRange struct {
+	StartAt string `json:"start_at,omitempty"`
+	EndAt   string `json:"end_at,omitempty"`
+}
+
+// ShiftWorkday represents a range of workdays, as dates in the YYYY-MM-DD format.
+type ShiftWorkday struct {
+	DateRange       *DateRange `json:"date_range,omitempty"`
+	MatchShiftsBy   string     `json:"match_shifts_by,omitempty"`
+	DefaultTimezone string     `json:"default_timezone,omitempty"`
+}
+
+// DateRange represents a range of dates in the YYYY-MM-DD format, both inclusive.
+type DateRange struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// ListWorkweekConfigs represents a list of workweek configurations.
+type ListWorkweekConfigs struct {
+	WorkweekConfigs []WorkweekConfigEntry `json:"workweek_configs"`
+	Cursor          string                `json:"cursor,omitempty"`
+}
+
+// WorkweekConfig represents a workweek configuration.
+type WorkweekConfig struct {
+	WorkweekConfig *WorkweekConfigEntry `json:"workweek_config"`
+}
+
+// WorkweekConfigEntry represents when the workweek of a seller starts, used to compute overtime.
+type WorkweekConfigEntry struct {
+	Id                  string     `json:"id,omitempty"`
+	StartOfWeek         string     `json:"start_of_week"`
+	StartOfDayLocalTime string     `json:"start_of_day_local_time"`
+	Version             int        `json:"version,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// Covers reports whether the shift was in progress at t. Open shifts cover any time after their start.
+func (s *ShiftEntry) Covers(t time.Time) bool {
+	if t.Before(s.StartAt) {
+		return false
+	}
+	return s.EndAt == nil || t.Before(*s.EndAt)
+}
+
+// TeamMemberOnShift returns the ID of the team member whose shift at the location covers t, such as the time a
+// terminal checkout was taken, or an empty string if nobody was on shift.
+func (l *ListShifts) TeamMemberOnShift(locationId string, t time.Time) string {
+	for i := range l.Shifts {
+		if l.Shifts[i].LocationId == locationId && l.Shifts[i].Covers(t) {
+			return l.Shifts[i].TeamMemberId
+		}
+	}
+	return ""
+}
+
+// ListBreakTypes returns a list of break types, optionally for a single location.
+func (s *LaborServiceOp) ListBreakTypes(ctx context.Context, options *ListOptions) (*ListBreakTypes, *Response, error) {
+	p, err := addOptions(path.Join(LaborBasePath, laborBreakTypesPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListBreakTypes)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateBreakType creates a break type.
+func (s *LaborServiceOp) CreateBreakType(ctx context.Context, breakType *CreateBreakType) (*BreakType, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LaborBasePath, laborBreakTypesPath), breakType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BreakType)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetBreakType returns a break type by ID.
+func (s *LaborServiceOp) GetBreakType(ctx context.Context, breakTypeId string) (*BreakType, *Response, error) {
+	if len(breakTypeId) == 0 {
+		return nil, nil, NewArgError("breakTypeId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LaborBasePath, laborBreakTypesPath, breakTypeId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BreakType)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateBreakType updates a break type.
+func (s *LaborServiceOp) UpdateBreakType(ctx context.Context, breakTypeId string, breakType *BreakTypeEntry) (*BreakType, *Response, error) {
+	if len(breakTypeId) == 0 {
+		return nil, nil, NewArgError("breakTypeId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(LaborBasePath, laborBreakTypesPath, breakTypeId), &BreakType{BreakType: breakType})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BreakType)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteBreakType deletes a break type.
+func (s *LaborServiceOp) DeleteBreakType(ctx context.Context, breakTypeId string) (*Response, error) {
+	if len(breakTypeId) == 0 {
+		return nil, NewArgError("breakTypeId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(LaborBasePath, laborBreakTypesPath, breakTypeId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// CreateShift creates a shift for a team member.
+func (s *LaborServiceOp) CreateShift(ctx context.Context, shift *CreateShift) (*Shift, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LaborBasePath, laborShiftsPath), shift)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Shift)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SearchShifts searches shifts by location, team member, status and time.
+func (s *LaborServiceOp) SearchShifts(ctx context.Context, search *SearchShifts) (*ListShifts, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LaborBasePath, laborShiftsPath, "search"), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListShifts)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetShift returns a shift by ID.
+func (s *LaborServiceOp) GetShift(ctx context.Context, shiftId string) (*Shift, *Response, error) {
+	if len(shiftId) == 0 {
+		return nil, nil, NewArgError("shiftId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LaborBasePath, laborShiftsPath, shiftId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Shift)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateShift updates a shift, e.g. to end it or record breaks.
+func (s *LaborServiceOp) UpdateShift(ctx context.Context, shiftId string, shift *ShiftEntry) (*Shift, *Response, error) {
+	if len(shiftId) == 0 {
+		return nil, nil, NewArgError("shiftId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(LaborBasePath, laborShiftsPath, shiftId), &Shift{Shift: shift})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Shift)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteShift deletes a shift.
+func (s *LaborServiceOp) DeleteShift(ctx context.Context, shiftId string) (*Response, error) {
+	if len(shiftId) == 0 {
+		return nil, NewArgError("shiftId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(LaborBasePath, laborShiftsPath, shiftId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListWorkweekConfigs returns a list of the workweek configurations of the seller.
+func (s *LaborServiceOp) ListWorkweekConfigs(ctx context.Context, options *ListOptions) (*ListWorkweekConfigs, *Response, error) {
+	p, err := addOptions(path.Join(LaborBasePath, laborWorkweekConfigsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListWorkweekConfigs)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateWorkweekConfig updates a workweek configuration.
+func (s *LaborServiceOp) UpdateWorkweekConfig(ctx context.Context, configId string, config *WorkweekConfigEntry) (*WorkweekConfig, *Response, error) {
+	if len(configId) == 0 {
+		return nil, nil, NewArgError("configId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(LaborBasePath, laborWorkweekConfigsPath, configId), &WorkweekConfig{WorkweekConfig: config})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WorkweekConfig)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
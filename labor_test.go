@@ -0,0 +1,261 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	searchShiftsResponse = `
+{
+  "shifts": [
+    {
+      "id": "X714F3HA6D1PT",
+      "location_id": "PAA1RJZZKXBFG",
+      "timezone": "America/New_York",
+      "start_at": "2019-01-21T03:11:00-05:00",
+      "end_at": "2019-01-21T13:11:00-05:00",
+      "status": "CLOSED",
+      "version": 6,
+      "team_member_id": "ormj0jJJZ5OZIzxrZYJI"
+    },
+    {
+      "id": "GDHYBZYWK0P2V",
+      "location_id": "PAA1RJZZKXBFG",
+      "timezone": "America/New_York",
+      "start_at": "2019-01-21T13:11:00-05:00",
+      "status": "OPEN",
+      "version": 1,
+      "team_member_id": "33fJchumvVdJwxV0H6L9"
+    }
+  ]
+}`
+)
+
+func TestLaborServiceOp_SearchShifts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/labor/shifts/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, searchShiftsResponse)
+	})
+
+	got, _, err := client.Labor.SearchShifts(ctx, &SearchShifts{
+		Query: &ShiftQuery{Filter: &ShiftFilter{LocationIds: []string{"PAA1RJZZKXBFG"}}},
+	})
+	if err != nil {
+		t.Fatalf("Labor.SearchShifts returned error: %v", err)
+	}
+
+	if len(got.Shifts) != 2 {
+		t.Fatalf("Labor.SearchShifts returned %d shifts, expected 2", len(got.Shifts))
+	}
+
+	tests := []struct {
+		at       string
+		location string
+		expected string
+	}{
+		{"2019-01-21T08:00:00-05:00", "PAA1RJZZKXBFG", "ormj0jJJZ5OZIzxrZYJI"},
+		{"2019-01-21T13:11:00-05:00", "PAA1RJZZKXBFG", "33fJchumvVdJwxV0H6L9"},
+		{"2019-01-22T09:00:00-05:00", "PAA1RJZZKXBFG", "33fJchumvVdJwxV0H6L9"},
+		{"2019-01-21T01:00:00-05:00", "PAA1RJZZKXBFG", ""},
+		{"2019-01-21T08:00:00-05:00", "L88917AVBK2S5", ""},
+	}
+	for _, tt := range tests {
+		at, err := time.Parse(time.RFC3339, tt.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id := got.TeamMemberOnShift(tt.location, at); id != tt.expected {
+			t.Errorf("TeamMemberOnShift(%q, %s) = %q, expected %q", tt.location, tt.at, id, tt.expected)
+		}
+	}
+}
+
+func TestLaborServiceOp_BreakTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	breakType := &BreakTypeEntry{
+		LocationId:       "CGJN03P1D08GF",
+		BreakName:        "Lunch Break",
+		ExpectedDuration: "PT30M",
+		IsPaid:           true,
+	}
+	response := `{"break_type":{"id":"49SSVDJG76WF3","location_id":"CGJN03P1D08GF","break_name":"Lunch Break","expected_duration":"PT30M","is_paid":true,"version":1}}`
+	expected := &BreakType{BreakType: &BreakTypeEntry{
+		Id:               "49SSVDJG76WF3",
+		LocationId:       "CGJN03P1D08GF",
+		BreakName:        "Lunch Break",
+		ExpectedDuration: "PT30M",
+		IsPaid:           true,
+		Version:          1,
+	}}
+
+	mux.HandleFunc("/v2/labor/break-types", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			testFormValues(t, r, values{"location_id": "CGJN03P1D08GF"})
+			fmt.Fprint(w, `{"break_types":[{"id":"49SSVDJG76WF3","location_id":"CGJN03P1D08GF","break_name":"Lunch Break","expected_duration":"PT30M","is_paid":true,"version":1}]}`)
+			return
+		}
+
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(CreateBreakType), &CreateBreakType{IdempotencyKey: "PAD3NG5KSN2GL", BreakType: breakType})
+		fmt.Fprint(w, response)
+	})
+
+	var deleted bool
+	mux.HandleFunc("/v2/labor/break-types/49SSVDJG76WF3", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			testJSONBody(t, r, new(BreakType), &BreakType{BreakType: breakType})
+		case http.MethodDelete:
+			deleted = true
+			fmt.Fprint(w, `{}`)
+			return
+		default:
+			testMethod(t, r, http.MethodGet)
+		}
+		fmt.Fprint(w, response)
+	})
+
+	list, _, err := client.Labor.ListBreakTypes(ctx, &ListOptions{LocationID: "CGJN03P1D08GF"})
+	if err != nil {
+		t.Fatalf("Labor.ListBreakTypes returned error: %v", err)
+	}
+	if !reflect.DeepEqual(list, &ListBreakTypes{BreakTypes: []BreakTypeEntry{*expected.BreakType}}) {
+		t.Errorf("Labor.ListBreakTypes returned %+v", list)
+	}
+
+	got, _, err := client.Labor.CreateBreakType(ctx, &CreateBreakType{IdempotencyKey: "PAD3NG5KSN2GL", BreakType: breakType})
+	if err != nil {
+		t.Fatalf("Labor.CreateBreakType returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Labor.CreateBreakType returned %+v, expected %+v", got, expected)
+	}
+
+	if got, _, err = client.Labor.GetBreakType(ctx, "49SSVDJG76WF3"); err != nil {
+		t.Fatalf("Labor.GetBreakType returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Labor.GetBreakType returned %+v, expected %+v", got, expected)
+	}
+
+	if got, _, err = client.Labor.UpdateBreakType(ctx, "49SSVDJG76WF3", breakType); err != nil {
+		t.Fatalf("Labor.UpdateBreakType returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Labor.UpdateBreakType returned %+v, expected %+v", got, expected)
+	}
+
+	if _, err := client.Labor.DeleteBreakType(ctx, "49SSVDJG76WF3"); err != nil {
+		t.Fatalf("Labor.DeleteBreakType returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("Labor.DeleteBreakType sent no DELETE request")
+	}
+
+	if _, _, err := client.Labor.GetBreakType(ctx, ""); err == nil {
+		t.Error("Labor.GetBreakType with an empty ID returned no error")
+	}
+	if _, _, err := client.Labor.UpdateBreakType(ctx, "", breakType); err == nil {
+		t.Error("Labor.UpdateBreakType with an empty ID returned no error")
+	}
+	if _, err := client.Labor.DeleteBreakType(ctx, ""); err == nil {
+		t.Error("Labor.DeleteBreakType with an empty ID returned no error")
+	}
+}
+
+func TestLaborServiceOp_Shifts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	startAt := time.Date(2019, 1, 25, 8, 11, 0, 0, time.UTC)
+	endAt := time.Date(2019, 1, 25, 18, 11, 0, 0, time.UTC)
+	shift := &ShiftEntry{
+		LocationId:   "PAA1RJZZKXBFG",
+		StartAt:      startAt,
+		EndAt:        &endAt,
+		TeamMemberId: "ormj0jJJZ5OZIzxrZYJI",
+		Wage: &ShiftWage{
+			Title:      "Barista",
+			HourlyRate: &AmountMoney{Amount: 1100, Currency: "USD"},
+		},
+	}
+	response := `{"shift":{"id":"K0YH4CV5462JB","location_id":"PAA1RJZZKXBFG","start_at":"2019-01-25T08:11:00Z","end_at":"2019-01-25T18:11:00Z","status":"CLOSED","version":1,"team_member_id":"ormj0jJJZ5OZIzxrZYJI"}}`
+	expected := &Shift{Shift: &ShiftEntry{
+		Id:           "K0YH4CV5462JB",
+		LocationId:   "PAA1RJZZKXBFG",
+		StartAt:      startAt,
+		EndAt:        &endAt,
+		Status:       ShiftStatusClosed,
+		Version:      1,
+		TeamMemberId: "ormj0jJJZ5OZIzxrZYJI",
+	}}
+
+	mux.HandleFunc("/v2/labor/shifts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(CreateShift), &CreateShift{IdempotencyKey: "HIDSNG5KS478L", Shift: shift})
+		fmt.Fprint(w, response)
+	})
+
+	var deleted bool
+	mux.HandleFunc("/v2/labor/shifts/K0YH4CV5462JB", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			testJSONBody(t, r, new(Shift), &Shift{Shift: shift})
+		case http.MethodDelete:
+			deleted = true
+			fmt.Fprint(w, `{}`)
+			return
+		default:
+			testMethod(t, r, http.MethodGet)
+		}
+		fmt.Fprint(w, response)
+	})
+
+	got, _, err := client.Labor.CreateShift(ctx, &CreateShift{IdempotencyKey: "HIDSNG5KS478L", Shift: shift})
+	if err != nil {
+		t.Fatalf("Labor.CreateShift returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Labor.CreateShift returned %+v, expected %+v", got, expected)
+	}
+
+	if got, _, err = client.Labor.GetShift(ctx, "K0YH4CV5462JB"); err != nil {
+		t.Fatalf("Labor.GetShift returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Labor.GetShift returned %+v, expected %+v", got, expected)
+	}
+
+	if got, _, err = client.Labor.UpdateShift(ctx, "K0YH4CV5462JB", shift); err != nil {
+		t.Fatalf("Labor.UpdateShift returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Labor.UpdateShift returned %+v, expected %+v", got, expected)
+	}
+
+	if _, err := client.Labor.DeleteShift(ctx, "K0YH4CV5462JB"); err != nil {
+		t.Fatalf("Labor.DeleteShift returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("Labor.DeleteShift sent no DELETE request")
+	}
+
+	if _, _, err := client.Labor.GetShift(ctx, ""); err == nil {
+		t.Error("Labor.GetShift with an empty ID returned no error")
+	}
+	if _, _, err := client.Labor.UpdateShift(ctx, "", shift); err == nil {
+		t.Error("Labor.UpdateShift with an empty ID returned no error")
+	}
+	if _, err := client.Labor.DeleteShift(ctx, ""); err == nil {
+		t.Error("Labor.DeleteShift with an empty ID returned no error")
+	}
+}
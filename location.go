@@ -0,0 +1,149 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	// LocationMain is the ID RetrieveLocation resolves to the main location of the merchant.
+	LocationMain = "main"
+)
+
+// Location statuses.
+const (
+	LocationStatusActive   = "ACTIVE"
+	LocationStatusInactive = "INACTIVE"
+)
+
+// Location types.
+const (
+	LocationTypePhysical = "PHYSICAL"
+	LocationTypeMobile   = "MOBILE"
+)
+
+// LocationService is an interface for interfacing with the Square Locations API.
+type LocationService interface {
+	ListLocations(ctx context.Context) (*ListLocations, *Response, error)
+	RetrieveLocation(ctx context.Context, locationId string) (*Location, *Response, error)
+	UpdateLocation(ctx context.Context, locationId string, location *LocationEntry) (*Location, *Response, error)
+}
+
+var _ LocationService = &LocationServiceOp{}
+
+// LocationServiceOp handles communication with the location related methods of the Square API.
+type LocationServiceOp struct {
+	client *Client
+}
+
+// ListLocations represents the list of the locations of a merchant. Locations are not paginated.
+type ListLocations struct {
+	Locations []LocationEntry `json:"locations"`
+}
+
+// Location represents a location.
+type Location struct {
+	Location *LocationEntry `json:"location"`
+}
+
+// LocationEntry represents a physical or mobile place of business of a merchant.
+type LocationEntry struct {
+	Id                string          `json:"id,omitempty"`
+	Name              string          `json:"name,omitempty"`
+	Address           *BillingAddress `json:"address,omitempty"`
+	Timezone          string          `json:"timezone,omitempty"`
+	Capabilities      []string        `json:"capabilities,omitempty"`
+	Status            string          `json:"status,omitempty"`
+	CreatedAt         *time.Time      `json:"created_at,omitempty"`
+	MerchantId        string          `json:"merchant_id,omitempty"`
+	Country           string          `json:"country,omitempty"`
+	LanguageCode      string          `json:"language_code,omitempty"`
+	Currency          string          `json:"currency,omitempty"`
+	PhoneNumber       string          `json:"phone_number,omitempty"`
+	BusinessName      string          `json:"business_name,omitempty"`
+	Type              string          `json:"type,omitempty"`
+	WebsiteUrl        string          `json:"website_url,omitempty"`
+	Description       string          `json:"description,omitempty"`
+	BusinessEmail     string          `json:"business_email,omitempty"`
+	Mcc               string          `json:"mcc,omitempty"`
+	TaxIds            *LocationTaxIds `json:"tax_ids,omitempty"`
+	FullFormatLogoUrl string          `json:"full_format_logo_url,omitempty"`
+	LogoUrl           string          `json:"logo_url,omitempty"`
+}
+
+// LocationTaxIds represents the tax IDs of a location, by country.
+type LocationTaxIds struct {
+	EuVat   string `json:"eu_vat,omitempty"`
+	FrSiret string `json:"fr_siret,omitempty"`
+	FrNaf   string `json:"fr_naf,omitempty"`
+	EsNif   string `json:"es_nif,omitempty"`
+	JpQii   string `json:"jp_qii,omitempty"`
+}
+
+// UpdateLocation represents an update of a location. Only the fields set are changed.
+type UpdateLocation struct {
+	Location *LocationEntry `json:"location"`
+}
+
+// ListLocations returns the locations of the merchant.
+func (s *LocationServiceOp) ListLocations(ctx context.Context) (*ListLocations, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, LocationBasePath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListLocations)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveLocation returns a location by ID. The main location of the merchant, LocationMain, is not cached, as
+// webhook events name locations by ID.
+func (s *LocationServiceOp) RetrieveLocation(ctx context.Context, locationId string) (*Location, *Response, error) {
+	if len(locationId) == 0 {
+		return nil, nil, NewArgError("locationId", "cannot be an empty string")
+	}
+
+	if locationId != LocationMain {
+		ctx = withCache(ctx, cacheTypeLocation, locationId)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LocationBasePath, locationId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Location)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateLocation updates a location.
+func (s *LocationServiceOp) UpdateLocation(ctx context.Context, locationId string, location *LocationEntry) (*Location, *Response, error) {
+	if len(locationId) == 0 {
+		return nil, nil, NewArgError("locationId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeLocation, locationId)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(LocationBasePath, locationId), &UpdateLocation{Location: location})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Location)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,97 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLocationServiceOp_ListLocations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/locations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"locations":[{"id":"18YC4JDH91E1H","name":"Grant Park","status":"ACTIVE","type":"PHYSICAL"}]}`)
+	})
+
+	got, _, err := client.Location.ListLocations(ctx)
+	if err != nil {
+		t.Fatalf("Location.ListLocations returned error: %v", err)
+	}
+
+	expected := &ListLocations{Locations: []LocationEntry{{
+		Id:     "18YC4JDH91E1H",
+		Name:   "Grant Park",
+		Status: LocationStatusActive,
+		Type:   LocationTypePhysical,
+	}}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Location.ListLocations returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestLocationServiceOp_RetrieveLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if err := SetCache(NewLRUCache(10, time.Hour))(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "DM7VKY8Q63GNP"}
+
+	var requests int
+	mux.HandleFunc("/v2/locations/18YC4JDH91E1H", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			requests++
+			fmt.Fprintf(w, `{"location":{"id":"18YC4JDH91E1H","name":"Grant Park %d"}}`, requests)
+		case http.MethodPut:
+			testJSONBody(t, r, new(UpdateLocation), &UpdateLocation{Location: &LocationEntry{Description: "Midtown"}})
+			fmt.Fprint(w, `{"location":{"id":"18YC4JDH91E1H","description":"Midtown"}}`)
+		default:
+			t.Errorf("Request method: %v", r.Method)
+		}
+	})
+	mux.HandleFunc("/v2/locations/main", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"location":{"id":"18YC4JDH91E1H"}}`)
+	})
+
+	retrieve := func(expected string) {
+		t.Helper()
+		got, _, err := client.Location.RetrieveLocation(ctx, "18YC4JDH91E1H")
+		if err != nil {
+			t.Fatalf("Location.RetrieveLocation returned error: %v", err)
+		}
+		if got.Location.Name != expected {
+			t.Errorf("Location.RetrieveLocation name = %q, expected %q", got.Location.Name, expected)
+		}
+	}
+
+	retrieve("Grant Park 1")
+	retrieve("Grant Park 1")
+
+	if _, _, err := client.Location.UpdateLocation(ctx, "18YC4JDH91E1H", &LocationEntry{Description: "Midtown"}); err != nil {
+		t.Fatalf("Location.UpdateLocation returned error: %v", err)
+	}
+	retrieve("Grant Park 2")
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Location.RetrieveLocation(ctx, LocationMain); err != nil {
+			t.Fatalf("Location.RetrieveLocation returned error: %v", err)
+		}
+	}
+	if requests != 4 {
+		t.Errorf("Location.RetrieveLocation made %d requests, expected 4", requests)
+	}
+
+	if _, _, err := client.Location.RetrieveLocation(ctx, ""); err == nil {
+		t.Error("Location.RetrieveLocation with an empty ID returned no error")
+	}
+	if _, _, err := client.Location.UpdateLocation(ctx, "", &LocationEntry{}); err == nil {
+		t.Error("Location.UpdateLocation with an empty ID returned no error")
+	}
+}
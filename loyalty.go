@@ -0,0 +1,702 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	LoyaltyBasePath = "v2/loyalty"
+
+	loyaltyProgramsPath   = "programs"
+	loyaltyAccountsPath   = "accounts"
+	loyaltyEventsPath     = "events"
+	loyaltyRewardsPath    = "rewards"
+	loyaltyPromotionsPath = "promotions"
+
+	// LoyaltyProgramMain is the keyword to retrieve the loyalty program of the seller without knowing its ID.
+	LoyaltyProgramMain = "main"
+)
+
+// LoyaltyAccrualType is the way buyers earn points under an accrual rule.
+type LoyaltyAccrualType string
+
+const (
+	LoyaltyAccrualTypeVisit         LoyaltyAccrualType = "VISIT"
+	LoyaltyAccrualTypeSpend         LoyaltyAccrualType = "SPEND"
+	LoyaltyAccrualTypeItemVariation LoyaltyAccrualType = "ITEM_VARIATION"
+	LoyaltyAccrualTypeCategory      LoyaltyAccrualType = "CATEGORY"
+)
+
+// Loyalty tax modes, used by visit and spend accrual rules.
+const (
+	LoyaltyTaxModeBeforeTax = "BEFORE_TAX"
+	LoyaltyTaxModeAfterTax  = "AFTER_TAX"
+)
+
+// Loyalty event types.
+const (
+	LoyaltyEventTypeAccumulatePoints          = "ACCUMULATE_POINTS"
+	LoyaltyEventTypeCreateReward              = "CREATE_REWARD"
+	LoyaltyEventTypeRedeemReward              = "REDEEM_REWARD"
+	LoyaltyEventTypeDeleteReward              = "DELETE_REWARD"
+	LoyaltyEventTypeAdjustPoints              = "ADJUST_POINTS"
+	LoyaltyEventTypeExpirePoints              = "EXPIRE_POINTS"
+	LoyaltyEventTypeOther                     = "OTHER"
+	LoyaltyEventTypeAccumulatePromotionPoints = "ACCUMULATE_PROMOTION_POINTS"
+)
+
+// Loyalty promotion incentive types.
+const (
+	LoyaltyPromotionIncentivePointsMultiplier = "POINTS_MULTIPLIER"
+	LoyaltyPromotionIncentivePointsAddition   = "POINTS_ADDITION"
+)
+
+// LoyaltyService is an interface for interfacing with the Square Loyalty API.
+type LoyaltyService interface {
+	RetrieveLoyaltyProgram(ctx context.Context, programId string) (*LoyaltyProgram, *Response, error)
+
+	CreateLoyaltyAccount(ctx context.Context, account *CreateLoyaltyAccount) (*LoyaltyAccount, *Response, error)
+	SearchLoyaltyAccounts(ctx context.Context, search *SearchLoyaltyAccounts) (*ListLoyaltyAccounts, *Response, error)
+	RetrieveLoyaltyAccount(ctx context.Context, accountId string) (*LoyaltyAccount, *Response, error)
+	AccumulateLoyaltyPoints(ctx context.Context, accountId string, accumulate *AccumulateLoyaltyPoints) (*ListLoyaltyEvents, *Response, error)
+	AdjustLoyaltyPoints(ctx context.Context, accountId string, adjust *AdjustLoyaltyPoints) (*LoyaltyEvent, *Response, error)
+
+	SearchLoyaltyEvents(ctx context.Context, search *SearchLoyaltyEvents) (*ListLoyaltyEvents, *Response, error)
+
+	CreateLoyaltyReward(ctx context.Context, reward *CreateLoyaltyReward) (*LoyaltyReward, *Response, error)
+	RetrieveLoyaltyReward(ctx context.Context, rewardId string) (*LoyaltyReward, *Response, error)
+	RedeemLoyaltyReward(ctx context.Context, rewardId string, redeem *RedeemLoyaltyReward) (*LoyaltyEvent, *Response, error)
+	DeleteLoyaltyReward(ctx context.Context, rewardId string) (*Response, error)
+
+	ListLoyaltyPromotions(ctx context.Context, programId string, options *ListOptions) (*ListLoyaltyPromotions, *Response, error)
+	CreateLoyaltyPromotion(ctx context.Context, programId string, promotion *CreateLoyaltyPromotion) (*LoyaltyPromotion, *Response, error)
+	RetrieveLoyaltyPromotion(ctx context.Context, programId, promotionId string) (*LoyaltyPromotion, *Response, error)
+	CancelLoyaltyPromotion(ctx context.Context, programId, promotionId string) (*LoyaltyPromotion, *Response, error)
+}
+
+var _ LoyaltyService = &LoyaltyServiceOp{}
+
+// LoyaltyServiceOp handles communication with the loyalty related methods of the Square API.
+type LoyaltyServiceOp struct {
+	client *Client
+}
+
+// LoyaltyProgram represents a loyalty program.
+type LoyaltyProgram struct {
+	Program *LoyaltyProgramEntry `json:"program"`
+}
+
+// LoyaltyProgramEntry represents the loyalty program of a seller.
+type LoyaltyProgramEntry struct {
+	Id               string                          `json:"id"`
+	Status           string                          `json:"status"`
+	RewardTiers      []LoyaltyProgramRewardTier      `json:"reward_tiers,omitempty"`
+	ExpirationPolicy *LoyaltyProgramExpirationPolicy `json:"expiration_policy,omitempty"`
+	Terminology      *LoyaltyProgramTerminology      `json:"terminology,omitempty"`
+	LocationIds      []string                        `json:"location_ids,omitempty"`
+	CreatedAt        *time.Time                      `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time                      `json:"updated_at,omitempty"`
+	AccrualRules     []LoyaltyProgramAccrualRule     `json:"accrual_rules,omitempty"`
+}
+
+// LoyaltyProgramRewardTier represents a reward buyers can claim for points.
+type LoyaltyProgramRewardTier struct {
+	Id                   string                `json:"id"`
+	Points               int                   `json:"points"`
+	Name                 string                `json:"name,omitempty"`
+	CreatedAt            *time.Time            `json:"created_at,omitempty"`
+	PricingRuleReference *CatalogObjectVersion `json:"pricing_rule_reference,omitempty"`
+}
+
+// CatalogObjectVersion represents a reference to a specific version of a catalog object.
+type CatalogObjectVersion struct {
+	ObjectId       string `json:"object_id"`
+	CatalogVersion int64  `json:"catalog_version,omitempty"`
+}
+
+// LoyaltyProgramExpirationPolicy represents when points expire.
+type LoyaltyProgramExpirationPolicy struct {
+	ExpirationDuration string `json:"expiration_duration"`
+}
+
+// LoyaltyProgramTerminology represents the name used for points in the buyer facing UI.
+type LoyaltyProgramTerminology struct {
+	One   string `json:"one"`
+	Other string `json:"other"`
+}
+
+// LoyaltyProgramAccrualRule represents how buyers earn points. Exactly one of the data fields matching
+// AccrualType is set.
+type LoyaltyProgramAccrualRule struct {
+	AccrualType       LoyaltyAccrualType                      `json:"accrual_type"`
+	Points            int                                     `json:"points,omitempty"`
+	VisitData         *LoyaltyProgramAccrualRuleVisitData     `json:"visit_data,omitempty"`
+	SpendData         *LoyaltyProgramAccrualRuleSpendData     `json:"spend_data,omitempty"`
+	ItemVariationData *LoyaltyProgramAccrualRuleItemVariation `json:"item_variation_data,omitempty"`
+	CategoryData      *LoyaltyProgramAccrualRuleCategory      `json:"category_data,omitempty"`
+}
+
+// LoyaltyProgramAccrualRuleVisitData represents the data of a VISIT accrual rule.
+type LoyaltyProgramAccrualRuleVisitData struct {
+	MinimumAmountMoney *AmountMoney `json:"minimum_amount_money,omitempty"`
+	TaxMode            string       `json:"tax_mode"`
+}
+
+// LoyaltyProgramAccrualRuleSpendData represents the data of a SPEND accrual rule.
+type LoyaltyProgramAccrualRuleSpendData struct {
+	AmountMoney              *AmountMoney `json:"amount_money"`
+	ExcludedCategoryIds      []string     `json:"excluded_category_ids,omitempty"`
+	ExcludedItemVariationIds []string     `json:"excluded_item_variation_ids,omitempty"`
+	TaxMode                  string       `json:"tax_mode"`
+}
+
+// LoyaltyProgramAccrualRuleItemVariation represents the data of an ITEM_VARIATION accrual rule.
+type LoyaltyProgramAccrualRuleItemVariation struct {
+	ItemVariationId string `json:"item_variation_id"`
+}
+
+// LoyaltyProgramAccrualRuleCategory represents the data of a CATEGORY accrual rule.
+type LoyaltyProgramAccrualRuleCategory struct {
+	CategoryId string `json:"category_id"`
+}
+
+// ListLoyaltyAccounts represents a list of loyalty accounts.
+type ListLoyaltyAccounts struct {
+	LoyaltyAccounts []LoyaltyAccountEntry `json:"loyalty_accounts"`
+	Cursor          string                `json:"cursor,omitempty"`
+}
+
+// LoyaltyAccount represents a loyalty account.
+type LoyaltyAccount struct {
+	LoyaltyAccount *LoyaltyAccountEntry `json:"loyalty_account"`
+}
+
+// LoyaltyAccountEntry represents the loyalty account of a buyer.
+type LoyaltyAccountEntry struct {
+	Id                     string                         `json:"id,omitempty"`
+	ProgramId              string                         `json:"program_id"`
+	Balance                int                            `json:"balance,omitempty"`
+	LifetimePoints         int                            `json:"lifetime_points,omitempty"`
+	CustomerId             string                         `json:"customer_id,omitempty"`
+	EnrolledAt             *time.Time                     `json:"enrolled_at,omitempty"`
+	CreatedAt              *time.Time                     `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time                     `json:"updated_at,omitempty"`
+	Mapping                *LoyaltyAccountMapping         `json:"mapping,omitempty"`
+	ExpiringPointDeadlines []LoyaltyAccountExpiringPoints `json:"expiring_point_deadlines,omitempty"`
+}
+
+// LoyaltyAccountMapping represents the phone number a loyalty account is mapped to.
+type LoyaltyAccountMapping struct {
+	Id          string     `json:"id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	PhoneNumber string     `json:"phone_number,omitempty"`
+}
+
+// LoyaltyAccountExpiringPoints represents points that expire at a given time.
+type LoyaltyAccountExpiringPoints struct {
+	Points    int        `json:"points"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreateLoyaltyAccount represents a loyalty account to be created.
+type CreateLoyaltyAccount struct {
+	LoyaltyAccount *LoyaltyAccountEntry `json:"loyalty_account"`
+	IdempotencyKey string               `json:"idempotency_key"`
+}
+
+// SearchLoyaltyAccounts represents a loyalty account search. Accounts can be searched by phone number mapping or
+// by customer ID.
+type SearchLoyaltyAccounts struct {
+	Query  *LoyaltyAccountQuery `json:"query,omitempty"`
+	Limit  int                  `json:"limit,omitempty"`
+	Cursor string               `json:"cursor,omitempty"`
+}
+
+// LoyaltyAccountQuery represents the filters of a loyalty account search.
+type LoyaltyAccountQuery struct {
+	Mappings    []LoyaltyAccountMapping `json:"mappings,omitempty"`
+	CustomerIds []string                `json:"customer_ids,omitempty"`
+}
+
+// AccumulateLoyaltyPoints represents points to be added to a loyalty account for a purchase.
+type AccumulateLoyaltyPoints struct {
+	AccumulatePoints *LoyaltyEventDetails `json:"accumulate_points"`
+	IdempotencyKey   string               `json:"idempotency_key"`
+	LocationId       string               `json:"location_id"`
+}
+
+// AdjustLoyaltyPoints represents a manual adjustment of the points of a loyalty account.
+type AdjustLoyaltyPoints struct {
+	IdempotencyKey       string               `json:"idempotency_key"`
+	AdjustPoints         *LoyaltyEventDetails `json:"adjust_points"`
+	AllowNegativeBalance bool                 `json:"allow_negative_balance,omitempty"`
+}
+
+// ListLoyaltyEvents represents a list of loyalty events.
+type ListLoyaltyEvents struct {
+	Events []LoyaltyEventEntry `json:"events"`
+	Cursor string              `json:"cursor,omitempty"`
+}
+
+// LoyaltyEvent represents a loyalty event.
+type LoyaltyEvent struct {
+	Event *LoyaltyEventEntry `json:"event"`
+}
+
+// LoyaltyEventEntry represents a change in the points of a loyalty account. Exactly one of the details fields
+// matching Type is set.
+type LoyaltyEventEntry struct {
+	Id                        string               `json:"id"`
+	Type                      string               `json:"type"`
+	CreatedAt                 *time.Time           `json:"created_at,omitempty"`
+	LoyaltyAccountId          string               `json:"loyalty_account_id"`
+	LocationId                string               `json:"location_id,omitempty"`
+	Source                    string               `json:"source,omitempty"`
+	AccumulatePoints          *LoyaltyEventDetails `json:"accumulate_points,omitempty"`
+	CreateReward              *LoyaltyEventDetails `json:"create_reward,omitempty"`
+	RedeemReward              *LoyaltyEventDetails `json:"redeem_reward,omitempty"`
+	DeleteReward              *LoyaltyEventDetails `json:"delete_reward,omitempty"`
+	AdjustPoints              *LoyaltyEventDetails `json:"adjust_points,omitempty"`
+	ExpirePoints              *LoyaltyEventDetails `json:"expire_points,omitempty"`
+	OtherEvent                *LoyaltyEventDetails `json:"other_event,omitempty"`
+	AccumulatePromotionPoints *LoyaltyEventDetails `json:"accumulate_promotion_points,omitempty"`
+}
+
+// LoyaltyEventDetails represents the type specific details of a loyalty event. Only the fields relevant to the
+// event type are populated.
+type LoyaltyEventDetails struct {
+	LoyaltyProgramId   string `json:"loyalty_program_id,omitempty"`
+	LoyaltyPromotionId string `json:"loyalty_promotion_id,omitempty"`
+	RewardId           string `json:"reward_id,omitempty"`
+	RewardTierId       string `json:"reward_tier_id,omitempty"`
+	OrderId            string `json:"order_id,omitempty"`
+	Points             int    `json:"points,omitempty"`
+	Reason             string `json:"reason,omitempty"`
+}
+
+// SearchLoyaltyEvents represents a loyalty event search.
+type SearchLoyaltyEvents struct {
+	Query  *LoyaltyEventQuery `json:"query,omitempty"`
+	Limit  int                `json:"limit,omitempty"`
+	Cursor string             `json:"cursor,omitempty"`
+}
+
+// LoyaltyEventQuery represents the filters of a loyalty event search.
+type LoyaltyEventQuery struct {
+	Filter LoyaltyEventFilter `json:"filter"`
+}
+
+// LoyaltyEventFilter represents the criteria loyalty events must all match. Unset criteria are ignored.
+type LoyaltyEventFilter struct {
+	LoyaltyAccountFilter *LoyaltyEventLoyaltyAccountFilter `json:"loyalty_account_filter,omitempty"`
+	TypeFilter           *LoyaltyEventTypeFilter           `json:"type_filter,omitempty"`
+	DateTimeFilter       *LoyaltyEventDateTimeFilter       `json:"date_time_filter,omitempty"`
+	LocationFilter       *LoyaltyEventLocationFilter       `json:"location_filter,omitempty"`
+	OrderFilter          *LoyaltyEventOrderFilter          `json:"order_filter,omitempty"`
+}
+
+// LoyaltyEventLoyaltyAccountFilter filters loyalty events by loyalty account.
+type LoyaltyEventLoyaltyAccountFilter struct {
+	LoyaltyAccountId string `json:"loyalty_account_id"`
+}
+
+// LoyaltyEventTypeFilter filters loyalty events by type.
+type LoyaltyEventTypeFilter struct {
+	Types []string `json:"types"`
+}
+
+// LoyaltyEventDateTimeFilter filters loyalty events by creation time.
+type LoyaltyEventDateTimeFilter struct {
+	CreatedAt TimeRange `json:"created_at"`
+}
+
+// LoyaltyEventLocationFilter filters loyalty events by the location they happened at.
+type LoyaltyEventLocationFilter struct {
+	LocationIds []string `json:"location_ids"`
+}
+
+// LoyaltyEventOrderFilter filters loyalty events by the order they were created for.
+type LoyaltyEventOrderFilter struct {
+	OrderId string `json:"order_id"`
+}
+
+// LoyaltyReward represents a loyalty reward.
+type LoyaltyReward struct {
+	Reward *LoyaltyRewardEntry `json:"reward"`
+}
+
+// LoyaltyRewardEntry represents a reward a buyer claimed with their points.
+type LoyaltyRewardEntry struct {
+	Id               string     `json:"id,omitempty"`
+	Status           string     `json:"status,omitempty"`
+	LoyaltyAccountId string     `json:"loyalty_account_id"`
+	RewardTierId     string     `json:"reward_tier_id"`
+	Points           int        `json:"points,omitempty"`
+	OrderId          string     `json:"order_id,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	RedeemedAt       *time.Time `json:"redeemed_at,omitempty"`
+}
+
+// CreateLoyaltyReward represents a loyalty reward to be created.
+type CreateLoyaltyReward struct {
+	Reward         *LoyaltyRewardEntry `json:"reward"`
+	IdempotencyKey string              `json:"idempotency_key"`
+}
+
+// RedeemLoyaltyReward represents the redemption of a loyalty reward.
+type RedeemLoyaltyReward struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	LocationId     string `json:"location_id"`
+}
+
+// ListLoyaltyPromotions represents a list of loyalty promotions.
+type ListLoyaltyPromotions struct {
+	LoyaltyPromotions []LoyaltyPromotionEntry `json:"loyalty_promotions"`
+	Cursor            string                  `json:"cursor,omitempty"`
+}
+
+// LoyaltyPromotion represents a loyalty promotion.
+type LoyaltyPromotion struct {
+	LoyaltyPromotion *LoyaltyPromotionEntry `json:"loyalty_promotion"`
+}
+
+// LoyaltyPromotionEntry represents a promotion that awards additional points.
+type LoyaltyPromotionEntry struct {
+	Id                         string                     `json:"id,omitempty"`
+	Name                       string                     `json:"name"`
+	Incentive                  *LoyaltyPromotionIncentive `json:"incentive"`
+	AvailableTime              *LoyaltyPromotionTime      `json:"available_time"`
+	TriggerLimit               *LoyaltyPromotionLimit     `json:"trigger_limit,omitempty"`
+	Status                     string                     `json:"status,omitempty"`
+	CreatedAt                  *time.Time                 `json:"created_at,omitempty"`
+	CanceledAt                 *time.Time                 `json:"canceled_at,omitempty"`
+	UpdatedAt                  *time.Time                 `json:"updated_at,omitempty"`
+	LoyaltyProgramId           string                     `json:"loyalty_program_id,omitempty"`
+	MinimumSpendAmountMoney    *AmountMoney               `json:"minimum_spend_amount_money,omitempty"`
+	QualifyingItemVariationIds []string                   `json:"qualifying_item_variation_ids,omitempty"`
+	QualifyingCategoryIds      []string                   `json:"qualifying_category_ids,omitempty"`
+}
+
+// LoyaltyPromotionIncentive represents how a promotion increases the points earned.
+type LoyaltyPromotionIncentive struct {
+	Type                 string `json:"type"`
+	PointsMultiplierData *struct {
+		Multiplier string `json:"multiplier"`
+	} `json:"points_multiplier_data,omitempty"`
+	PointsAdditionData *struct {
+		PointsAddition int `json:"points_addition"`
+	} `json:"points_addition_data,omitempty"`
+}
+
+// LoyaltyPromotionTime represents when a promotion is available.
+type LoyaltyPromotionTime struct {
+	StartDate   string   `json:"start_date,omitempty"`
+	EndDate     string   `json:"end_date,omitempty"`
+	TimePeriods []string `json:"time_periods"`
+}
+
+// LoyaltyPromotionLimit represents how many times a buyer can trigger a promotion.
+type LoyaltyPromotionLimit struct {
+	Times    int    `json:"times"`
+	Interval string `json:"interval,omitempty"`
+}
+
+// CreateLoyaltyPromotion represents a loyalty promotion to be created.
+type CreateLoyaltyPromotion struct {
+	LoyaltyPromotion *LoyaltyPromotionEntry `json:"loyalty_promotion"`
+	IdempotencyKey   string                 `json:"idempotency_key"`
+}
+
+// RetrieveLoyaltyProgram returns a loyalty program by ID, or the program of the seller with LoyaltyProgramMain.
+func (s *LoyaltyServiceOp) RetrieveLoyaltyProgram(ctx context.Context, programId string) (*LoyaltyProgram, *Response, error) {
+	if len(programId) == 0 {
+		return nil, nil, NewArgError("programId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LoyaltyBasePath, loyaltyProgramsPath, programId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyProgram)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateLoyaltyAccount creates a loyalty account for a buyer.
+func (s *LoyaltyServiceOp) CreateLoyaltyAccount(ctx context.Context, account *CreateLoyaltyAccount) (*LoyaltyAccount, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyAccountsPath), account)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyAccount)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SearchLoyaltyAccounts searches loyalty accounts by phone number or customer ID.
+func (s *LoyaltyServiceOp) SearchLoyaltyAccounts(ctx context.Context, search *SearchLoyaltyAccounts) (*ListLoyaltyAccounts, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyAccountsPath, "search"), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListLoyaltyAccounts)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveLoyaltyAccount returns a loyalty account by ID.
+func (s *LoyaltyServiceOp) RetrieveLoyaltyAccount(ctx context.Context, accountId string) (*LoyaltyAccount, *Response, error) {
+	if len(accountId) == 0 {
+		return nil, nil, NewArgError("accountId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LoyaltyBasePath, loyaltyAccountsPath, accountId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyAccount)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// AccumulateLoyaltyPoints adds points earned for an order to a loyalty account. Square computes the points from
+// the order when AccumulatePoints.OrderId is set.
+func (s *LoyaltyServiceOp) AccumulateLoyaltyPoints(ctx context.Context, accountId string, accumulate *AccumulateLoyaltyPoints) (*ListLoyaltyEvents, *Response, error) {
+	if len(accountId) == 0 {
+		return nil, nil, NewArgError("accountId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyAccountsPath, accountId, "accumulate"), accumulate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListLoyaltyEvents)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// AdjustLoyaltyPoints adds points to or subtracts points from a loyalty account.
+func (s *LoyaltyServiceOp) AdjustLoyaltyPoints(ctx context.Context, accountId string, adjust *AdjustLoyaltyPoints) (*LoyaltyEvent, *Response, error) {
+	if len(accountId) == 0 {
+		return nil, nil, NewArgError("accountId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyAccountsPath, accountId, "adjust"), adjust)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyEvent)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SearchLoyaltyEvents searches the loyalty events of the seller.
+func (s *LoyaltyServiceOp) SearchLoyaltyEvents(ctx context.Context, search *SearchLoyaltyEvents) (*ListLoyaltyEvents, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyEventsPath, "search"), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListLoyaltyEvents)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateLoyaltyReward creates a reward for a loyalty account, locking the points of the reward tier.
+func (s *LoyaltyServiceOp) CreateLoyaltyReward(ctx context.Context, reward *CreateLoyaltyReward) (*LoyaltyReward, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyRewardsPath), reward)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyReward)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveLoyaltyReward returns a loyalty reward by ID.
+func (s *LoyaltyServiceOp) RetrieveLoyaltyReward(ctx context.Context, rewardId string) (*LoyaltyReward, *Response, error) {
+	if len(rewardId) == 0 {
+		return nil, nil, NewArgError("rewardId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LoyaltyBasePath, loyaltyRewardsPath, rewardId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyReward)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RedeemLoyaltyReward redeems a loyalty reward.
+func (s *LoyaltyServiceOp) RedeemLoyaltyReward(ctx context.Context, rewardId string, redeem *RedeemLoyaltyReward) (*LoyaltyEvent, *Response, error) {
+	if len(rewardId) == 0 {
+		return nil, nil, NewArgError("rewardId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyRewardsPath, rewardId, "redeem"), redeem)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyEvent)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteLoyaltyReward deletes a reward that has not been redeemed, returning its points to the account.
+func (s *LoyaltyServiceOp) DeleteLoyaltyReward(ctx context.Context, rewardId string) (*Response, error) {
+	if len(rewardId) == 0 {
+		return nil, NewArgError("rewardId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(LoyaltyBasePath, loyaltyRewardsPath, rewardId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoyaltyPromotions returns a list of the promotions of a loyalty program.
+func (s *LoyaltyServiceOp) ListLoyaltyPromotions(ctx context.Context, programId string, options *ListOptions) (*ListLoyaltyPromotions, *Response, error) {
+	if len(programId) == 0 {
+		return nil, nil, NewArgError("programId", "cannot be an empty string")
+	}
+
+	p, err := addOptions(path.Join(LoyaltyBasePath, loyaltyProgramsPath, programId, loyaltyPromotionsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListLoyaltyPromotions)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateLoyaltyPromotion creates a promotion for a loyalty program.
+func (s *LoyaltyServiceOp) CreateLoyaltyPromotion(ctx context.Context, programId string, promotion *CreateLoyaltyPromotion) (*LoyaltyPromotion, *Response, error) {
+	if len(programId) == 0 {
+		return nil, nil, NewArgError("programId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyProgramsPath, programId, loyaltyPromotionsPath), promotion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyPromotion)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveLoyaltyPromotion returns a loyalty promotion by ID.
+func (s *LoyaltyServiceOp) RetrieveLoyaltyPromotion(ctx context.Context, programId, promotionId string) (*LoyaltyPromotion, *Response, error) {
+	if len(programId) == 0 {
+		return nil, nil, NewArgError("programId", "cannot be an empty string")
+	}
+	if len(promotionId) == 0 {
+		return nil, nil, NewArgError("promotionId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(LoyaltyBasePath, loyaltyProgramsPath, programId, loyaltyPromotionsPath, promotionId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyPromotion)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CancelLoyaltyPromotion cancels an active or scheduled loyalty promotion.
+func (s *LoyaltyServiceOp) CancelLoyaltyPromotion(ctx context.Context, programId, promotionId string) (*LoyaltyPromotion, *Response, error) {
+	if len(programId) == 0 {
+		return nil, nil, NewArgError("programId", "cannot be an empty string")
+	}
+	if len(promotionId) == 0 {
+		return nil, nil, NewArgError("promotionId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(LoyaltyBasePath, loyaltyProgramsPath, programId, loyaltyPromotionsPath, promotionId, "cancel"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(LoyaltyPromotion)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
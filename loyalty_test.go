@@ -0,0 +1,131 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var (
+	retrieveLoyaltyProgramResponse = `
+{
+  "program": {
+    "id": "d619f755-2d17-41f3-990d-c04ecedd64dd",
+    "status": "ACTIVE",
+    "reward_tiers": [
+      {
+        "id": "e1b39225-9da5-43d1-a5db-782cdd8ad94f",
+        "points": 10,
+        "name": "10% off entire sale"
+      }
+    ],
+    "terminology": {
+      "one": "Point",
+      "other": "Points"
+    },
+    "location_ids": ["P034NEENMD09F"],
+    "accrual_rules": [
+      {
+        "accrual_type": "SPEND",
+        "points": 1,
+        "spend_data": {
+          "amount_money": {"amount": 100, "currency": "USD"},
+          "excluded_category_ids": ["7ZERJKO5PVYXCVUHV2JCZ2UG"],
+          "tax_mode": "BEFORE_TAX"
+        }
+      },
+      {
+        "accrual_type": "ITEM_VARIATION",
+        "points": 2,
+        "item_variation_data": {
+          "item_variation_id": "HAQOCSGBAJFEFEMWGJ64N2WY"
+        }
+      }
+    ]
+  }
+}`
+)
+
+func TestLoyaltyServiceOp_RetrieveLoyaltyProgram(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/loyalty/programs/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, retrieveLoyaltyProgramResponse)
+	})
+
+	got, _, err := client.Loyalty.RetrieveLoyaltyProgram(ctx, LoyaltyProgramMain)
+	if err != nil {
+		t.Fatalf("Loyalty.RetrieveLoyaltyProgram returned error: %v", err)
+	}
+
+	expected := []LoyaltyProgramAccrualRule{
+		{
+			AccrualType: LoyaltyAccrualTypeSpend,
+			Points:      1,
+			SpendData: &LoyaltyProgramAccrualRuleSpendData{
+				AmountMoney:         &AmountMoney{Amount: 100, Currency: "USD"},
+				ExcludedCategoryIds: []string{"7ZERJKO5PVYXCVUHV2JCZ2UG"},
+				TaxMode:             LoyaltyTaxModeBeforeTax,
+			},
+		},
+		{
+			AccrualType: LoyaltyAccrualTypeItemVariation,
+			Points:      2,
+			ItemVariationData: &LoyaltyProgramAccrualRuleItemVariation{
+				ItemVariationId: "HAQOCSGBAJFEFEMWGJ64N2WY",
+			},
+		},
+	}
+	if !reflect.DeepEqual(got.Program.AccrualRules, expected) {
+		t.Errorf("Loyalty.RetrieveLoyaltyProgram accrual rules = %+v, expected %+v", got.Program.AccrualRules, expected)
+	}
+}
+
+func TestLoyaltyServiceOp_SearchLoyaltyAccounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	search := &SearchLoyaltyAccounts{
+		Query: &LoyaltyAccountQuery{
+			Mappings: []LoyaltyAccountMapping{{PhoneNumber: "+14155551234"}},
+		},
+		Limit: 10,
+	}
+
+	mux.HandleFunc("/v2/loyalty/accounts/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(SearchLoyaltyAccounts)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, search) {
+			t.Errorf("Request body = %+v, expected %+v", v, search)
+		}
+
+		fmt.Fprint(w, `{"loyalty_accounts":[{"id":"79b807d2-d786-46a9-933b-918028d7a8c5","program_id":"d619f755-2d17-41f3-990d-c04ecedd64dd","balance":10,"customer_id":"Q8002FAM9V1EZ0ADB2T5609X6NET1H0"}]}`)
+	})
+
+	got, _, err := client.Loyalty.SearchLoyaltyAccounts(ctx, search)
+	if err != nil {
+		t.Fatalf("Loyalty.SearchLoyaltyAccounts returned error: %v", err)
+	}
+
+	expected := &ListLoyaltyAccounts{
+		LoyaltyAccounts: []LoyaltyAccountEntry{
+			{
+				Id:         "79b807d2-d786-46a9-933b-918028d7a8c5",
+				ProgramId:  "d619f755-2d17-41f3-990d-c04ecedd64dd",
+				Balance:    10,
+				CustomerId: "Q8002FAM9V1EZ0ADB2T5609X6NET1H0",
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Loyalty.SearchLoyaltyAccounts returned %+v, expected %+v", got, expected)
+	}
+}
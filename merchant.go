@@ -0,0 +1,108 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	MerchantBasePath = "v2/merchants"
+
+	// MerchantMe is the ID RetrieveMerchant resolves to the merchant of the access token.
+	MerchantMe = "me"
+)
+
+// Merchant statuses.
+const (
+	MerchantStatusActive   = "ACTIVE"
+	MerchantStatusInactive = "INACTIVE"
+)
+
+// MerchantService is an interface for interfacing with the Square Merchants API.
+type MerchantService interface {
+	ListMerchants(ctx context.Context, options *ListOptions) (*ListMerchants, *Response, error)
+	RetrieveMerchant(ctx context.Context, merchantId string) (*Merchant, *Response, error)
+	CurrentMerchant(ctx context.Context) (*Merchant, *Response, error)
+}
+
+var _ MerchantService = &MerchantServiceOp{}
+
+// MerchantServiceOp handles communication with the merchant related methods of the Square API.
+type MerchantServiceOp struct {
+	client *Client
+}
+
+// ListMerchants represents a list of merchants. Unlike other list endpoints, the cursor is a number.
+type ListMerchants struct {
+	Merchant []MerchantEntry `json:"merchant"`
+	Cursor   int             `json:"cursor,omitempty"`
+}
+
+// Merchant represents a merchant.
+type Merchant struct {
+	Merchant *MerchantEntry `json:"merchant"`
+}
+
+// MerchantEntry represents a business that accepts payments with Square.
+type MerchantEntry struct {
+	Id             string    `json:"id"`
+	BusinessName   string    `json:"business_name,omitempty"`
+	Country        string    `json:"country"`
+	LanguageCode   string    `json:"language_code,omitempty"`
+	Currency       string    `json:"currency,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	MainLocationId string    `json:"main_location_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ListMerchants returns the merchants the access token can act on behalf of.
+func (s *MerchantServiceOp) ListMerchants(ctx context.Context, options *ListOptions) (*ListMerchants, *Response, error) {
+	p, err := addOptions(MerchantBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListMerchants)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveMerchant returns a merchant by ID. The merchant of the access token, MerchantMe, is not cached, as
+// webhook events name merchants by ID.
+func (s *MerchantServiceOp) RetrieveMerchant(ctx context.Context, merchantId string) (*Merchant, *Response, error) {
+	if len(merchantId) == 0 {
+		return nil, nil, NewArgError("merchantId", "cannot be an empty string")
+	}
+
+	if merchantId != MerchantMe {
+		ctx = withCache(ctx, cacheTypeMerchant, merchantId)
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(MerchantBasePath, merchantId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Merchant)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CurrentMerchant returns the merchant of the access token.
+func (s *MerchantServiceOp) CurrentMerchant(ctx context.Context) (*Merchant, *Response, error) {
+	return s.RetrieveMerchant(ctx, MerchantMe)
+}
@@ -1,5 +1,106 @@
 package squareup
 
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerSquareVersion      = "Square-Version"
+	headerDeprecation        = "Deprecation"
+	headerSunset             = "Sunset"
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// Meta describes generic information about a response, taken from its body and headers. Fields the response
+// does not carry are left zero.
 type Meta struct {
+	// Cursor is the cursor of the next page of a list response. It is empty on the last page.
 	Cursor string `json:"cursor"`
+
+	// RequestID is the ID Square assigned to the request, to be quoted in support tickets.
+	RequestID string `json:"request_id"`
+
+	// SquareVersion is the API version the server answered with.
+	SquareVersion string `json:"square_version"`
+
+	// Deprecation is the Deprecation header, set when the requested version or endpoint is deprecated.
+	Deprecation string `json:"deprecation,omitempty"`
+
+	// Sunset is the time after which the requested version or endpoint is retired, if announced.
+	Sunset *time.Time `json:"sunset,omitempty"`
+
+	// RateLimitLimit is the number of requests allowed in the current rate limit window.
+	RateLimitLimit int `json:"rate_limit_limit,omitempty"`
+
+	// RateLimitRemaining is the number of requests left in the current rate limit window.
+	RateLimitRemaining int `json:"rate_limit_remaining,omitempty"`
+
+	// RateLimitReset is the number of seconds until the current rate limit window resets.
+	RateLimitReset int `json:"rate_limit_reset,omitempty"`
+
+	// RetryAfter is how long to wait before retrying a rate limited or unavailable request.
+	RetryAfter time.Duration `json:"retry_after,omitempty"`
+}
+
+// newMeta creates a Meta from the headers of r.
+func newMeta(r *http.Response) *Meta {
+	h := r.Header
+	m := &Meta{
+		RequestID:          h.Get(headerRequestID),
+		SquareVersion:      h.Get(headerSquareVersion),
+		Deprecation:        h.Get(headerDeprecation),
+		RateLimitLimit:     headerInt(h, headerRateLimitLimit),
+		RateLimitRemaining: headerInt(h, headerRateLimitRemaining),
+		RateLimitReset:     headerInt(h, headerRateLimitReset),
+	}
+
+	if v := h.Get(headerSunset); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			m.Sunset = &t
+		}
+	}
+
+	if v := h.Get(headerRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			m.RetryAfter = time.Duration(seconds) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			m.RetryAfter = time.Until(t)
+		}
+	}
+
+	return m
+}
+
+// setCursor sets the cursor of m from the cursor field of a JSON response body, if any. Most endpoints return
+// the cursor as a string, but some return a number.
+func (m *Meta) setCursor(data []byte) {
+	var body struct {
+		Cursor json.RawMessage `json:"cursor"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil || len(body.Cursor) == 0 {
+		return
+	}
+
+	var cursor string
+	if err := json.Unmarshal(body.Cursor, &cursor); err != nil {
+		cursor = string(body.Cursor)
+	}
+	if cursor != "null" {
+		m.Cursor = cursor
+	}
+}
+
+// headerInt returns the integer value of the header key, or 0 if it is missing or malformed.
+func headerInt(h http.Header, key string) int {
+	v, err := strconv.Atoi(h.Get(key))
+	if err != nil {
+		return 0
+	}
+	return v
 }
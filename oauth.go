@@ -0,0 +1,279 @@
+package squareup
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	oauthAuthorizePath   = "oauth2/authorize"
+	oauthTokenPath       = "oauth2/token"
+	oauthRevokePath      = "oauth2/revoke"
+	oauthTokenStatusPath = "oauth2/token/status"
+
+	// defaultTokenExpiryDelta is how long before its expiry an access token is refreshed.
+	defaultTokenExpiryDelta = 24 * time.Hour
+)
+
+// OAuth grant types.
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeMigrationToken    = "migration_token"
+)
+
+// OAuthService is an interface for interfacing with the Square OAuth API.
+type OAuthService interface {
+	AuthorizeURL(clientId string, scopes []string, state string) (string, error)
+	ObtainToken(ctx context.Context, token *ObtainToken) (*OAuthToken, *Response, error)
+	RevokeToken(ctx context.Context, clientSecret string, revoke *RevokeToken) (*RevokeTokenResult, *Response, error)
+	RetrieveTokenStatus(ctx context.Context, accessToken string) (*TokenStatus, *Response, error)
+}
+
+var _ OAuthService = &OAuthServiceOp{}
+
+// OAuthServiceOp handles communication with the OAuth related methods of the Square API.
+type OAuthServiceOp struct {
+	client *Client
+}
+
+// ObtainToken represents a request for an OAuth access token.
+type ObtainToken struct {
+	ClientId       string   `json:"client_id"`
+	ClientSecret   string   `json:"client_secret,omitempty"`
+	GrantType      string   `json:"grant_type"`
+	Code           string   `json:"code,omitempty"`
+	RedirectUri    string   `json:"redirect_uri,omitempty"`
+	RefreshToken   string   `json:"refresh_token,omitempty"`
+	MigrationToken string   `json:"migration_token,omitempty"`
+	Scopes         []string `json:"scopes,omitempty"`
+	ShortLived     bool     `json:"short_lived,omitempty"`
+	CodeVerifier   string   `json:"code_verifier,omitempty"`
+}
+
+// OAuthToken represents an OAuth access token issued for a merchant.
+type OAuthToken struct {
+	AccessToken           string    `json:"access_token"`
+	TokenType             string    `json:"token_type"`
+	ExpiresAt             time.Time `json:"expires_at"`
+	MerchantId            string    `json:"merchant_id"`
+	SubscriptionId        string    `json:"subscription_id,omitempty"`
+	PlanId                string    `json:"plan_id,omitempty"`
+	IdToken               string    `json:"id_token,omitempty"`
+	RefreshToken          string    `json:"refresh_token,omitempty"`
+	ShortLived            bool      `json:"short_lived,omitempty"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at,omitempty"`
+}
+
+// RevokeToken represents a request to revoke the access tokens of a merchant.
+type RevokeToken struct {
+	ClientId              string `json:"client_id"`
+	AccessToken           string `json:"access_token,omitempty"`
+	MerchantId            string `json:"merchant_id,omitempty"`
+	RevokeOnlyAccessToken bool   `json:"revoke_only_access_token,omitempty"`
+}
+
+// RevokeTokenResult represents the result of a token revocation.
+type RevokeTokenResult struct {
+	Success bool `json:"success"`
+}
+
+// TokenStatus represents the status of an access token.
+type TokenStatus struct {
+	Scopes     []string  `json:"scopes"`
+	ExpiresAt  time.Time `json:"expires_at,omitempty"`
+	ClientId   string    `json:"client_id"`
+	MerchantId string    `json:"merchant_id"`
+}
+
+// AuthorizeURL returns the URL of the Square authorization page to send a seller to. state should be an
+// unguessable value, such as one returned by NewOAuthState, which is checked when Square redirects back.
+func (s *OAuthServiceOp) AuthorizeURL(clientId string, scopes []string, state string) (string, error) {
+	if len(clientId) == 0 {
+		return "", NewArgError("clientId", "cannot be an empty string")
+	}
+
+	u, err := s.client.BaseURL.Parse(oauthAuthorizePath)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("client_id", clientId)
+	if len(scopes) > 0 {
+		q.Set("scope", strings.Join(scopes, " "))
+	}
+	if state != "" {
+		q.Set("state", state)
+	}
+	q.Set("session", "false")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// withoutTokenSource returns a copy of ctx which makes requests created with it skip the token source of the
+// client. OAuth calls carry their own credentials, and must not depend on a token which may have expired.
+func withoutTokenSource(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noTokenSourceKey{}, true)
+}
+
+// noTokenSourceKey is the context key of the flag set by withoutTokenSource.
+type noTokenSourceKey struct{}
+
+// ObtainToken returns an OAuth access token and a refresh token, from an authorization code or a refresh token.
+func (s *OAuthServiceOp) ObtainToken(ctx context.Context, token *ObtainToken) (*OAuthToken, *Response, error) {
+	ctx = withoutTokenSource(ctx)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, oauthTokenPath, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Del("Authorization")
+
+	root := new(OAuthToken)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RevokeToken revokes an access token generated with the OAuth flow. The request is authorized with the
+// application's client secret.
+func (s *OAuthServiceOp) RevokeToken(ctx context.Context, clientSecret string, revoke *RevokeToken) (*RevokeTokenResult, *Response, error) {
+	if len(clientSecret) == 0 {
+		return nil, nil, NewArgError("clientSecret", "cannot be an empty string")
+	}
+
+	ctx = withoutTokenSource(ctx)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, oauthRevokePath, revoke)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", "Client "+clientSecret)
+
+	root := new(RevokeTokenResult)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveTokenStatus returns information about the given access token, such as its scopes and expiry.
+func (s *OAuthServiceOp) RetrieveTokenStatus(ctx context.Context, accessToken string) (*TokenStatus, *Response, error) {
+	if len(accessToken) == 0 {
+		return nil, nil, NewArgError("accessToken", "cannot be an empty string")
+	}
+
+	ctx = withoutTokenSource(ctx)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, oauthTokenStatusPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	root := new(TokenStatus)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// NewOAuthState returns a random value suitable for the state parameter of AuthorizeURL, to protect the
+// authorization flow against CSRF.
+func NewOAuthState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// TokenSource supplies the access token used to authorize the requests of a Client.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshTokenSource is a TokenSource that transparently refreshes an OAuth access token shortly before it
+// expires. It is safe for concurrent use.
+type RefreshTokenSource struct {
+	mu sync.Mutex
+
+	oauth        OAuthService
+	clientId     string
+	clientSecret string
+	token        *OAuthToken
+	expiryDelta  time.Duration
+	onRefresh    func(*OAuthToken)
+}
+
+var _ TokenSource = &RefreshTokenSource{}
+
+// NewRefreshTokenSource creates a TokenSource from an OAuth token previously returned by ObtainToken. Tokens are
+// refreshed through the OAuth API of c, with its configuration (API version, headers, limiter...). c may be the
+// client the source is then set on: the token endpoint is called without the token source of the client.
+func NewRefreshTokenSource(c *Client, clientId, clientSecret string, token *OAuthToken) *RefreshTokenSource {
+	return &RefreshTokenSource{
+		oauth:        &OAuthServiceOp{client: c},
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		token:        token,
+		expiryDelta:  defaultTokenExpiryDelta,
+	}
+}
+
+// OnRefresh registers a function called with every newly obtained token, so it can be persisted.
+func (s *RefreshTokenSource) OnRefresh(fn func(*OAuthToken)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onRefresh = fn
+}
+
+// Token returns a valid access token, refreshing it first if it expires within the expiry delta.
+func (s *RefreshTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.AccessToken != "" &&
+		(s.token.ExpiresAt.IsZero() || time.Until(s.token.ExpiresAt) > s.expiryDelta) {
+		return s.token.AccessToken, nil
+	}
+
+	if s.token == nil || s.token.RefreshToken == "" {
+		return "", NewArgError("token", "has expired and has no refresh token")
+	}
+
+	// The options of the request being authorized, e.g. its idempotency key, are not those of the refresh.
+	token, _, err := s.oauth.ObtainToken(withoutRequestOptions(ctx), &ObtainToken{
+		ClientId:     s.clientId,
+		ClientSecret: s.clientSecret,
+		GrantType:    GrantTypeRefreshToken,
+		RefreshToken: s.token.RefreshToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+	s.token = token
+
+	if s.onRefresh != nil {
+		s.onRefresh(token)
+	}
+
+	return token.AccessToken, nil
+}
@@ -0,0 +1,204 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOAuthServiceOp_AuthorizeURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	got, err := client.OAuth.AuthorizeURL("sq0idp-abc", []string{"PAYMENTS_READ", "PAYMENTS_WRITE"}, "csrf-state")
+	if err != nil {
+		t.Fatalf("OAuth.AuthorizeURL returned error: %v", err)
+	}
+
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("url.Parse(): %v", err)
+	}
+	if u.Path != "/oauth2/authorize" {
+		t.Errorf("AuthorizeURL path = %v, expected %v", u.Path, "/oauth2/authorize")
+	}
+
+	expected := url.Values{
+		"client_id": {"sq0idp-abc"},
+		"scope":     {"PAYMENTS_READ PAYMENTS_WRITE"},
+		"state":     {"csrf-state"},
+		"session":   {"false"},
+	}
+	if !reflect.DeepEqual(u.Query(), expected) {
+		t.Errorf("AuthorizeURL query = %v, expected %v", u.Query(), expected)
+	}
+}
+
+func TestRefreshTokenSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.SquareVersion = "2024-07-17"
+	if err := SetRequestHeaders(map[string]string{"X-Tenant": "acme"})(client); err != nil {
+		t.Fatalf("SetRequestHeaders(): %v", err)
+	}
+
+	refreshes := 0
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Authorization header = %v, expected none", auth)
+		}
+		if v := r.Header.Get(headerSquareVersion); v != "2024-07-17" {
+			t.Errorf("Square-Version header = %v, expected %v", v, "2024-07-17")
+		}
+		if v := r.Header.Get("X-Tenant"); v != "acme" {
+			t.Errorf("X-Tenant header = %v, expected %v", v, "acme")
+		}
+
+		v := new(ObtainToken)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		expected := &ObtainToken{
+			ClientId:     "sq0idp-abc",
+			ClientSecret: "secret",
+			GrantType:    GrantTypeRefreshToken,
+			RefreshToken: "refresh-1",
+		}
+		if !reflect.DeepEqual(v, expected) {
+			t.Errorf("Request body = %+v, expected %+v", v, expected)
+		}
+
+		refreshes++
+		fmt.Fprintf(w, `{"access_token":"access-2","token_type":"bearer","expires_at":%q,"merchant_id":"M1"}`,
+			time.Now().Add(30*24*time.Hour).UTC().Format(time.RFC3339))
+	})
+
+	mux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer access-2" {
+			t.Errorf("Authorization header = %v, expected %v", auth, "Bearer access-2")
+		}
+		fmt.Fprint(w, `{"payments":[]}`)
+	})
+
+	ts := NewRefreshTokenSource(client, "sq0idp-abc", "secret", &OAuthToken{
+		AccessToken:  "access-1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+		RefreshToken: "refresh-1",
+	})
+
+	var persisted *OAuthToken
+	ts.OnRefresh(func(token *OAuthToken) {
+		persisted = token
+	})
+
+	if err := SetTokenSource(ts)(client); err != nil {
+		t.Fatalf("SetTokenSource(): %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Payment.ListPayment(ctx, nil); err != nil {
+			t.Fatalf("Payment.ListPayment returned error: %v", err)
+		}
+	}
+
+	if refreshes != 1 {
+		t.Errorf("token refreshed %d times, expected 1", refreshes)
+	}
+	if persisted == nil || persisted.AccessToken != "access-2" || persisted.RefreshToken != "refresh-1" {
+		t.Errorf("OnRefresh token = %+v, expected access-2 with the previous refresh token", persisted)
+	}
+}
+
+func TestRefreshTokenSource_requestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cache := NewLRUCache(10, time.Hour)
+	if err := SetCache(cache)(client); err != nil {
+		t.Fatal(err)
+	}
+	client.cacheMerchant = &cacheMerchant{id: "M1"}
+	cache.Set(cacheKey("M1", client.SquareVersion, cacheTypeVendor, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"), []byte(`{}`))
+	cache.Set(cacheKey("M1", "2023-01-19", cacheTypeVendor, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4"), []byte(`{}`))
+
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if v := r.Header.Get(headerSquareVersion); v != client.SquareVersion {
+			t.Errorf("Square-Version header = %v, expected %v", v, client.SquareVersion)
+		}
+		if v := r.Header.Get("X-Call"); v != "" {
+			t.Errorf("X-Call header = %v, expected none", v)
+		}
+
+		fields := make(map[string]interface{})
+		if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := fields["idempotency_key"]; ok {
+			t.Errorf("Request body = %v, expected no idempotency_key", fields)
+		}
+
+		fmt.Fprint(w, `{"access_token":"access-2","token_type":"bearer","merchant_id":"M1"}`)
+	})
+
+	ts := NewRefreshTokenSource(client, "sq0idp-abc", "secret", &OAuthToken{
+		AccessToken:  "access-1",
+		ExpiresAt:    time.Now().Add(-time.Hour),
+		RefreshToken: "refresh-1",
+	})
+
+	callCtx := WithRequestOptions(ctx,
+		WithIdempotencyKey("4935a656-a929-4792-b97c-8848be85c27c"),
+		WithHeader("X-Call", "1"),
+		WithVersion("2023-01-19"))
+	callCtx = withCache(callCtx, cacheTypeVendor, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4")
+
+	token, err := ts.Token(callCtx)
+	if err != nil {
+		t.Fatalf("RefreshTokenSource.Token returned error: %v", err)
+	}
+	if token != "access-2" {
+		t.Errorf("RefreshTokenSource.Token = %q, expected %q", token, "access-2")
+	}
+	if cache.Len() != 2 {
+		t.Errorf("Token refresh left %d cache entries, expected 2", cache.Len())
+	}
+}
+
+func TestOAuthServiceOp_ObtainToken_expiredTokenSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"access_token":"access-2","token_type":"bearer","merchant_id":"M1"}`)
+	})
+
+	// A source whose token expired without a refresh token fails, which must not block obtaining a new token.
+	ts := NewRefreshTokenSource(client, "sq0idp-abc", "secret", &OAuthToken{
+		AccessToken: "access-1",
+		ExpiresAt:   time.Now().Add(-time.Hour),
+	})
+	if err := SetTokenSource(ts)(client); err != nil {
+		t.Fatalf("SetTokenSource(): %v", err)
+	}
+
+	token, _, err := client.OAuth.ObtainToken(ctx, &ObtainToken{
+		ClientId:     "sq0idp-abc",
+		ClientSecret: "secret",
+		GrantType:    GrantTypeAuthorizationCode,
+		Code:         "sq0cgp-code",
+	})
+	if err != nil {
+		t.Fatalf("OAuth.ObtainToken returned error: %v", err)
+	}
+	if token.AccessToken != "access-2" {
+		t.Errorf("OAuth.ObtainToken access token = %q, expected %q", token.AccessToken, "access-2")
+	}
+}
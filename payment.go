@@ -2,6 +2,7 @@ package squareup
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"path"
 	"time"
@@ -9,16 +10,28 @@ import (
 
 const (
 	PaymentBasePath = "v2/payments"
+
+	// CardBrandSquareGiftCard is the card brand of payments taken with a Square gift card.
+	CardBrandSquareGiftCard = "SQUARE_GIFT_CARD"
+
+	// PaymentSourceTypeCash is the source type of payments taken in cash.
+	PaymentSourceTypeCash = "CASH"
+
+	// PaymentStatusCompleted is the status of payments which were captured.
+	PaymentStatusCompleted = "COMPLETED"
 )
 
 type PaymentService interface {
-	ListPayment(ctx context.Context, options *ListOptions) (*ListPayments, *Response, error)
-	CreatePayment(ctx context.Context, payment *CreatePayment) (*Payment, *Response, error)
-	CancelByIdempotencyKey(ctx context.Context, id string) (*Payment, *Response, error)
-	GetPayment(ctx context.Context, paymentId string) (*Payment, *Response, error)
-	UpdatePayment(ctx context.Context, paymentId string, payment *Payment) (*Payment, *Response, error)
-	CancelPayment(ctx context.Context, paymentId string) (*Payment, *Response, error)
-	CompletePayment(ctx context.Context, paymentId, versionToken string) (*Payment, *Response, error)
+	ListPayment(ctx context.Context, options *ListOptions, opts ...RequestOption) (*ListPayments, *Response, error)
+	CreatePayment(ctx context.Context, payment *CreatePayment, opts ...RequestOption) (*Payment, *Response, error)
+	CancelByIdempotencyKey(ctx context.Context, id string, opts ...RequestOption) (*Payment, *Response, error)
+	GetPayment(ctx context.Context, paymentId string, opts ...RequestOption) (*Payment, *Response, error)
+	UpdatePayment(ctx context.Context, paymentId string, payment *UpdatePayment, opts ...RequestOption) (*Payment, *Response, error)
+	AdjustTip(ctx context.Context, paymentId string, tip *AmountMoney, opts ...RequestOption) (*Payment, *Response, error)
+	AdjustApprovedAmount(ctx context.Context, paymentId string, approved *AmountMoney, opts ...RequestOption) (*Payment, *Response, error)
+	CancelPayment(ctx context.Context, paymentId string, opts ...RequestOption) (*Payment, *Response, error)
+	CompletePayment(ctx context.Context, paymentId, versionToken string, opts ...RequestOption) (*Payment, *Response, error)
+	BatchGetPayments(ctx context.Context, paymentIds []string, workers int, opts ...RequestOption) map[string]BatchResult[*Payment]
 }
 
 var _ PaymentService = &PaymentServiceOp{}
@@ -46,9 +59,10 @@ type PaymentEntry struct {
 	AmountMoney        *AmountMoney        `json:"amount_money,omitempty"`
 	AppFeeMoney        *AmountMoney        `json:"app_fee_money,omitempty"`
 	Status             string              `json:"status,omitempty"`
-	DelayDuration      string              `json:"delay_duration,omitempty"`
+	DelayDuration      Duration            `json:"delay_duration,omitempty"`
 	SourceType         string              `json:"source_type,omitempty"`
 	CardDetails        *CardDetails        `json:"card_details,omitempty"`
+	CashDetails        *CashDetails        `json:"cash_details,omitempty"`
 	LocationId         string              `json:"location_id,omitempty"`
 	OrderId            string              `json:"order_id,omitempty"`
 	ReferenceId        string              `json:"reference_id,omitempty"`
@@ -57,6 +71,7 @@ type PaymentEntry struct {
 	CustomerId         string              `json:"customer_id,omitempty"`
 	TotalMoney         *AmountMoney        `json:"total_money,omitempty"`
 	ApprovedMoney      *AmountMoney        `json:"approved_money,omitempty"`
+	TipMoney           *AmountMoney        `json:"tip_money,omitempty"`
 	Capabilities       []string            `json:"capabilities,omitempty"`
 	ExternalDetails    *ExternalDetails    `json:"external_details,omitempty"`
 	ReceiptNumber      string              `json:"receipt_number,omitempty"`
@@ -64,9 +79,17 @@ type PaymentEntry struct {
 	DelayAction        string              `json:"delay_action,omitempty"`
 	DelayedUntil       time.Time           `json:"delayed_until,omitempty"`
 	ApplicationDetails *ApplicationDetails `json:"application_details,omitempty"`
+	DeviceDetails      *DeviceDetails      `json:"device_details,omitempty"`
 	VersionToken       string              `json:"version_token,omitempty"`
 }
 
+// DeviceDetails represents the device a payment was taken on.
+type DeviceDetails struct {
+	DeviceId             string `json:"device_id,omitempty"`
+	DeviceInstallationId string `json:"device_installation_id,omitempty"`
+	DeviceName           string `json:"device_name,omitempty"`
+}
+
 type CardDetails struct {
 	Status               string               `json:"status,omitempty"`
 	Card                 *Card                `json:"card,omitempty"`
@@ -94,6 +117,30 @@ type CardPaymentTimeline struct {
 	CapturedAt   time.Time `json:"captured_at"`
 }
 
+// PaymentGiftCardDetails represents the gift card used to take a payment.
+type PaymentGiftCardDetails struct {
+	Last4       string
+	Fingerprint string
+	Status      string
+	EntryMethod string
+}
+
+// GiftCardDetails returns the details of the gift card used to take the payment, or nil if the payment was not
+// taken with a Square gift card. Square reports gift card payments as card payments with the SQUARE_GIFT_CARD
+// brand; use GiftCardService.RetrieveGiftCardFromGAN to look the card up.
+func (p *PaymentEntry) GiftCardDetails() *PaymentGiftCardDetails {
+	if p.CardDetails == nil || p.CardDetails.Card == nil || p.CardDetails.Card.CardBrand != CardBrandSquareGiftCard {
+		return nil
+	}
+
+	return &PaymentGiftCardDetails{
+		Last4:       p.CardDetails.Card.Last4,
+		Fingerprint: p.CardDetails.Card.Fingerprint,
+		Status:      p.CardDetails.Status,
+		EntryMethod: p.CardDetails.EntryMethod,
+	}
+}
+
 type RiskEvaluation struct {
 	CreatedAt time.Time `json:"created_at"`
 	RiskLevel string    `json:"risk_level"`
@@ -113,7 +160,8 @@ type ExternalDetails struct {
 	SourceId       string       `json:"source_id,omitempty"`
 }
 
-// CreatePayment represents a payment to be created.
+// CreatePayment represents a payment to be created. SourceId accepts a card or gift card payment token, a card
+// on file ID, a gift card ID, or one of CASH and EXTERNAL.
 type CreatePayment struct {
 	IdempotencyKey                 string           `json:"idempotency_key"`
 	SourceId                       string           `json:"source_id"`
@@ -129,7 +177,7 @@ type CreatePayment struct {
 	CustomerDetails                *CustomerDetail  `json:"customer_details,omitempty"`
 	CustomerId                     string           `json:"customer_id,omitempty"`
 	DelayAction                    string           `json:"delay_action,omitempty"`
-	DelayDuration                  string           `json:"delay_duration,omitempty"`
+	DelayDuration                  Duration         `json:"delay_duration,omitempty"`
 	ExternalDetails                *ExternalDetails `json:"external_details,omitempty"`
 	LocationId                     string           `json:"location_id,omitempty"`
 	Note                           string           `json:"note,omitempty"`
@@ -174,7 +222,9 @@ type completePayment struct {
 }
 
 // ListPayment returns a list of payments taken by the account making the request.
-func (s *PaymentServiceOp) ListPayment(ctx context.Context, options *ListOptions) (*ListPayments, *Response, error) {
+func (s *PaymentServiceOp) ListPayment(ctx context.Context, options *ListOptions, opts ...RequestOption) (*ListPayments, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	p := PaymentBasePath
 	p, err := addOptions(p, options)
 	if err != nil {
@@ -196,7 +246,9 @@ func (s *PaymentServiceOp) ListPayment(ctx context.Context, options *ListOptions
 }
 
 // CreatePayment creates a payment.
-func (s *PaymentServiceOp) CreatePayment(ctx context.Context, payment *CreatePayment) (*Payment, *Response, error) {
+func (s *PaymentServiceOp) CreatePayment(ctx context.Context, payment *CreatePayment, opts ...RequestOption) (*Payment, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, PaymentBasePath, payment)
 	if err != nil {
 		return nil, nil, err
@@ -212,7 +264,9 @@ func (s *PaymentServiceOp) CreatePayment(ctx context.Context, payment *CreatePay
 }
 
 // CancelByIdempotencyKey cancels a payment by idempotency key.
-func (s *PaymentServiceOp) CancelByIdempotencyKey(ctx context.Context, idempotencyKey string) (*Payment, *Response, error) {
+func (s *PaymentServiceOp) CancelByIdempotencyKey(ctx context.Context, idempotencyKey string, opts ...RequestOption) (*Payment, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	p := path.Join(PaymentBasePath, "cancel")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, p, CancelPaymentByIdempotencyKey{IdempotencyKey: idempotencyKey})
 	if err != nil {
@@ -229,7 +283,9 @@ func (s *PaymentServiceOp) CancelByIdempotencyKey(ctx context.Context, idempoten
 }
 
 // GetPayment returns a payment by ID.
-func (s *PaymentServiceOp) GetPayment(ctx context.Context, paymentId string) (*Payment, *Response, error) {
+func (s *PaymentServiceOp) GetPayment(ctx context.Context, paymentId string, opts ...RequestOption) (*Payment, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	p := path.Join(PaymentBasePath, paymentId)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
 	if err != nil {
@@ -245,8 +301,15 @@ func (s *PaymentServiceOp) GetPayment(ctx context.Context, paymentId string) (*P
 	return root, resp, nil
 }
 
-// UpdatePayment updates a payment.
-func (s *PaymentServiceOp) UpdatePayment(ctx context.Context, paymentId string, payment *Payment) (*Payment, *Response, error) {
+// UpdatePayment updates the amounts of an approved payment which is not completed yet. Set the VersionToken of
+// the update to the one of the payment to make sure it did not change since it was read.
+func (s *PaymentServiceOp) UpdatePayment(ctx context.Context, paymentId string, payment *UpdatePayment, opts ...RequestOption) (*Payment, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(paymentId) == 0 {
+		return nil, nil, NewArgError("paymentId", "cannot be an empty string")
+	}
+
 	p := path.Join(PaymentBasePath, paymentId)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, p, payment)
 	if err != nil {
@@ -262,8 +325,67 @@ func (s *PaymentServiceOp) UpdatePayment(ctx context.Context, paymentId string,
 	return root, resp, nil
 }
 
+// AdjustTip sets the tip of an approved payment, e.g. once the buyer has added it to the receipt.
+func (s *PaymentServiceOp) AdjustTip(ctx context.Context, paymentId string, tip *AmountMoney, opts ...RequestOption) (*Payment, *Response, error) {
+	if tip == nil {
+		return nil, nil, NewArgError("tip", "cannot be nil")
+	}
+
+	return s.updateLatest(ctx, paymentId, func(d *UpdatePaymentDetails) {
+		d.TipMoney = tip
+	}, opts...)
+}
+
+// AdjustApprovedAmount sets the amount approved for an approved payment, which bounds the total it can be
+// completed for.
+func (s *PaymentServiceOp) AdjustApprovedAmount(ctx context.Context, paymentId string, approved *AmountMoney, opts ...RequestOption) (*Payment, *Response, error) {
+	if approved == nil {
+		return nil, nil, NewArgError("approved", "cannot be nil")
+	}
+
+	return s.updateLatest(ctx, paymentId, func(d *UpdatePaymentDetails) {
+		d.ApprovedMoney = approved
+	}, opts...)
+}
+
+// updateLatest reads the payment, applies mutate to an update carrying its current version token and sends it
+// under a new idempotency key. It starts over if the payment changed in the meantime. An update keyed with
+// WithIdempotencyKey is sent once under that key, as starting over would reuse the key for another update.
+func (s *PaymentServiceOp) updateLatest(ctx context.Context, paymentId string, mutate func(*UpdatePaymentDetails), opts ...RequestOption) (*Payment, *Response, error) {
+	callerKey := requestOptionsFrom(WithRequestOptions(ctx, opts...)).idempotencyKey
+	attempts := DefaultVersionRetryAttempts
+	if len(callerKey) > 0 {
+		attempts = 1
+	}
+
+	return WithVersionRetry(ctx, attempts,
+		func(ctx context.Context) (*Payment, *Response, error) {
+			return s.GetPayment(ctx, paymentId, opts...)
+		},
+		func(ctx context.Context, latest *Payment) (*Payment, *Response, error) {
+			if latest == nil || latest.Payment == nil {
+				return nil, nil, fmt.Errorf("squareup: payment %s has no payment in its response", paymentId)
+			}
+			details := &UpdatePaymentDetails{VersionToken: latest.Payment.VersionToken}
+			mutate(details)
+
+			if len(callerKey) > 0 {
+				return s.UpdatePayment(ctx, paymentId, &UpdatePayment{Payment: details, IdempotencyKey: callerKey}, opts...)
+			}
+
+			key, err := NewIdempotencyKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			// A key reused across attempts would replay the first, stale, update.
+			return s.UpdatePayment(ctx, paymentId, &UpdatePayment{Payment: details, IdempotencyKey: key}, opts...)
+		})
+}
+
 // CancelPayment cancels a payment.
-func (s *PaymentServiceOp) CancelPayment(ctx context.Context, paymentId string) (*Payment, *Response, error) {
+func (s *PaymentServiceOp) CancelPayment(ctx context.Context, paymentId string, opts ...RequestOption) (*Payment, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	p := path.Join(PaymentBasePath, paymentId, "cancel")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, p, nil)
 	if err != nil {
@@ -279,16 +401,19 @@ func (s *PaymentServiceOp) CancelPayment(ctx context.Context, paymentId string)
 	return root, resp, nil
 }
 
-// CompletePayment completes a payment.
-func (s *PaymentServiceOp) CompletePayment(ctx context.Context, paymentId, versionToken string) (*Payment, *Response, error) {
+// CompletePayment completes a payment. If versionToken is set, the payment is only completed if it is still at
+// that version; see WithVersionRetry to retry on ErrVersionMismatch.
+func (s *PaymentServiceOp) CompletePayment(ctx context.Context, paymentId, versionToken string, opts ...RequestOption) (*Payment, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	p := PaymentBasePath + "/" + paymentId + "/complete"
 
-	var cpp *completePayment
+	var body interface{}
 	if versionToken != "" {
-		cpp.VersionToken = versionToken
+		body = &completePayment{VersionToken: versionToken}
 	}
 
-	req, err := s.client.NewRequest(ctx, http.MethodPost, p, cpp)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, p, body)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -301,3 +426,12 @@ func (s *PaymentServiceOp) CompletePayment(ctx context.Context, paymentId, versi
 
 	return root, resp, nil
 }
+
+// BatchGetPayments returns the payments with the given IDs, retrieving at most workers of them at a time
+// (DefaultBatchWorkers if workers is not positive). The results are keyed by payment ID, each holding either the
+// payment or the error which prevented retrieving it.
+func (s *PaymentServiceOp) BatchGetPayments(ctx context.Context, paymentIds []string, workers int, opts ...RequestOption) map[string]BatchResult[*Payment] {
+	return batchGet(ctx, paymentIds, workers, func(ctx context.Context, id string) (*Payment, *Response, error) {
+		return s.GetPayment(ctx, id, opts...)
+	})
+}
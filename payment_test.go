@@ -3,9 +3,12 @@ package squareup
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -217,7 +220,7 @@ func TestPaymentServiceOp_CreatePayment(t *testing.T) {
 				Currency: "USD",
 			},
 			Status:        "COMPLETED",
-			DelayDuration: "PT168H",
+			DelayDuration: Duration(168 * time.Hour),
 			SourceType:    "CARD",
 			CardDetails: &CardDetails{
 				Status: "CAPTURED",
@@ -352,3 +355,291 @@ func TestPaymentServiceOp_ListPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentServiceOp_CreatePayment_requestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		if got := r.Header.Get("X-Trace-Id"); got != "trace-1" {
+			t.Errorf("X-Trace-Id = %q, expected %q", got, "trace-1")
+		}
+		if got := r.Header.Get("Square-Version"); got != "2024-07-17" {
+			t.Errorf("Square-Version = %q, expected %q", got, "2024-07-17")
+		}
+
+		v := new(CreatePayment)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if v.IdempotencyKey != "explicit-key" || v.SourceId != "CASH" {
+			t.Errorf("Request body = %+v", v)
+		}
+
+		fmt.Fprint(w, `{"payment":{"id":"GQTFp1ZlXdpoW4o6eGiZhbjosiDFf"}}`)
+	})
+
+	payment := &CreatePayment{
+		IdempotencyKey: "model-key",
+		SourceId:       "CASH",
+		AmountMoney:    &AmountMoney{Amount: 1000, Currency: "USD"},
+		CashDetails:    &CashDetails{BuyerSuppliedMoney: &AmountMoney{Amount: 1000, Currency: "USD"}},
+	}
+	_, _, err := client.Payment.CreatePayment(ctx, payment,
+		WithHeader("X-Trace-Id", "trace-1"),
+		WithVersion("2024-07-17"),
+		WithIdempotencyKey("explicit-key"),
+	)
+	if err != nil {
+		t.Fatalf("Payment.CreatePayment returned error: %v", err)
+	}
+}
+
+func TestPaymentServiceOp_CancelPayment_idempotencyKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf/cancel", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent without its idempotency key")
+	})
+
+	_, _, err := client.Payment.CancelPayment(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", WithIdempotencyKey("explicit-key"))
+	var argErr *ArgError
+	if !errors.As(err, &argErr) || argErr.Arg() != "idempotencyKey" {
+		t.Errorf("Payment.CancelPayment returned %v, expected an idempotencyKey error", err)
+	}
+}
+
+func TestPaymentServiceOp_GetPayment_timeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	_, _, err := client.Payment.GetPayment(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", WithTimeout(10*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Payment.GetPayment returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
+
+// handleAdjustTip serves a payment whose version changes once, so the first update fails with VERSION_MISMATCH,
+// and returns the idempotency keys of the updates.
+func handleAdjustTip(t *testing.T) *[]string {
+	var (
+		gets int
+		keys []string
+	)
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			gets++
+			fmt.Fprintf(w, `{"payment":{"id":"GQTFp1ZlXdpoW4o6eGiZhbjosiDFf","version_token":"v%d"}}`, gets)
+			return
+		}
+
+		testMethod(t, r, http.MethodPut)
+
+		v := new(UpdatePayment)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, v.IdempotencyKey)
+
+		expected := &UpdatePaymentDetails{
+			TipMoney:     &AmountMoney{Amount: 200, Currency: "USD"},
+			VersionToken: fmt.Sprintf("v%d", gets),
+		}
+		if !reflect.DeepEqual(v.Payment, expected) {
+			t.Errorf("Request body payment = %+v, expected %+v", v.Payment, expected)
+		}
+
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors":[{"category":"INVALID_REQUEST_ERROR","code":"VERSION_MISMATCH"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"payment":{"id":"GQTFp1ZlXdpoW4o6eGiZhbjosiDFf","tip_money":{"amount":200,"currency":"USD"}}}`)
+	})
+	return &keys
+}
+
+func TestPaymentServiceOp_AdjustTip(t *testing.T) {
+	setup()
+	defer teardown()
+
+	keys := handleAdjustTip(t)
+
+	tip := &AmountMoney{Amount: 200, Currency: "USD"}
+	payment, _, err := client.Payment.AdjustTip(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", tip)
+	if err != nil {
+		t.Fatalf("Payment.AdjustTip returned error: %v", err)
+	}
+	if len(*keys) != 2 {
+		t.Fatalf("Payment.AdjustTip sent %d updates, expected 2", len(*keys))
+	}
+	if k := *keys; len(k[0]) == 0 || k[0] == k[1] {
+		t.Errorf("Payment.AdjustTip idempotency keys = %q, expected a new key per update", k)
+	}
+	if payment.Payment.TipMoney.Amount != 200 {
+		t.Errorf("Payment.AdjustTip tip = %d, expected 200", payment.Payment.TipMoney.Amount)
+	}
+}
+
+func TestPaymentServiceOp_AdjustTip_idempotencyKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	keys := handleAdjustTip(t)
+
+	tip := &AmountMoney{Amount: 200, Currency: "USD"}
+	_, _, err := client.Payment.AdjustTip(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", tip, WithIdempotencyKey("caller-key"))
+	if !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("Payment.AdjustTip returned %v, expected %v", err, ErrVersionMismatch)
+	}
+	if expected := []string{"caller-key"}; !reflect.DeepEqual(*keys, expected) {
+		t.Errorf("Payment.AdjustTip idempotency keys = %q, expected %q", *keys, expected)
+	}
+}
+
+func TestPaymentServiceOp_AdjustTip_noPayment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := client.Payment.AdjustTip(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", &AmountMoney{Amount: 200, Currency: "USD"})
+	if err == nil {
+		t.Error("Payment.AdjustTip of a response without a payment returned no error")
+	}
+}
+
+func TestPaymentServiceOp_CompletePayment_versionToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf/complete", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(completePayment)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if v.VersionToken != "56Oc6V4LJ3upXEy5Jzu8VR8ROZWFk6kfxt5oQRoxEAxo" {
+			t.Errorf("Request body version_token = %q", v.VersionToken)
+		}
+
+		fmt.Fprint(w, `{"payment":{"id":"GQTFp1ZlXdpoW4o6eGiZhbjosiDFf","status":"COMPLETED"}}`)
+	})
+
+	payment, _, err := client.Payment.CompletePayment(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", "56Oc6V4LJ3upXEy5Jzu8VR8ROZWFk6kfxt5oQRoxEAxo")
+	if err != nil {
+		t.Fatalf("Payment.CompletePayment returned error: %v", err)
+	}
+	if payment.Payment.Status != PaymentStatusCompleted {
+		t.Errorf("Payment.CompletePayment status = %q, expected %q", payment.Payment.Status, PaymentStatusCompleted)
+	}
+}
+
+func TestWithVersionRetry_exhausted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var completes int
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"payment":{"id":"GQTFp1ZlXdpoW4o6eGiZhbjosiDFf","version_token":"stale"}}`)
+	})
+	mux.HandleFunc("/v2/payments/GQTFp1ZlXdpoW4o6eGiZhbjosiDFf/complete", func(w http.ResponseWriter, r *http.Request) {
+		completes++
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"errors":[{"category":"INVALID_REQUEST_ERROR","code":"VERSION_MISMATCH"}]}`)
+	})
+
+	_, _, err := WithVersionRetry(ctx, 2,
+		func(ctx context.Context) (*Payment, *Response, error) {
+			return client.Payment.GetPayment(ctx, "GQTFp1ZlXdpoW4o6eGiZhbjosiDFf")
+		},
+		func(ctx context.Context, p *Payment) (*Payment, *Response, error) {
+			return client.Payment.CompletePayment(ctx, p.Payment.Id, p.Payment.VersionToken)
+		})
+	if !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("WithVersionRetry returned %v, expected %v", err, ErrVersionMismatch)
+	}
+	if completes != 2 {
+		t.Errorf("WithVersionRetry made %d attempts, expected 2", completes)
+	}
+}
+
+type countingLimiter struct {
+	mu    sync.Mutex
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waits++
+	return nil
+}
+
+func TestPaymentServiceOp_BatchGetPayments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	limiter := new(countingLimiter)
+	client.limiter = limiter
+
+	var mu sync.Mutex
+	limited := false
+	mux.HandleFunc("/v2/payments/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		id := path.Base(r.URL.Path)
+		switch id {
+		case "missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"category":"INVALID_REQUEST_ERROR","code":"NOT_FOUND"}]}`)
+			return
+		case "limited":
+			mu.Lock()
+			first := !limited
+			limited = true
+			mu.Unlock()
+			if first {
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"errors":[{"category":"RATE_LIMIT_ERROR","code":"RATE_LIMITED"}]}`)
+				return
+			}
+		}
+		fmt.Fprintf(w, `{"payment":{"id":%q}}`, id)
+	})
+
+	results := client.Payment.BatchGetPayments(ctx, []string{"found", "missing", "limited", "found"}, 2)
+
+	if len(results) != 3 {
+		t.Fatalf("Payment.BatchGetPayments returned %d results, expected 3", len(results))
+	}
+	for _, id := range []string{"found", "limited"} {
+		if r := results[id]; r.Err != nil || r.Value.Payment.Id != id {
+			t.Errorf("Payment.BatchGetPayments result %q = %+v", id, r)
+		}
+	}
+	var errorResponse *ErrorResponse
+	if r := results["missing"]; !errors.As(r.Err, &errorResponse) || !errorResponse.HasErrorCode("NOT_FOUND") {
+		t.Errorf("Payment.BatchGetPayments result %q error = %v, expected NOT_FOUND", "missing", r.Err)
+	}
+	if limiter.waits != 4 {
+		t.Errorf("Limiter waited %d times, expected 4", limiter.waits)
+	}
+}
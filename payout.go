@@ -0,0 +1,290 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	PayoutBasePath = "v2/payouts"
+)
+
+// PayoutStatus is the status of a payout.
+type PayoutStatus string
+
+const (
+	PayoutStatusSent   PayoutStatus = "SENT"
+	PayoutStatusFailed PayoutStatus = "FAILED"
+	PayoutStatusPaid   PayoutStatus = "PAID"
+)
+
+// PayoutEntryType is the type of money movement recorded by a payout ledger entry.
+type PayoutEntryType string
+
+const (
+	PayoutEntryTypeAdjustment                   PayoutEntryType = "ADJUSTMENT"
+	PayoutEntryTypeAppFeeRefund                 PayoutEntryType = "APP_FEE_REFUND"
+	PayoutEntryTypeAppFeeRevenue                PayoutEntryType = "APP_FEE_REVENUE"
+	PayoutEntryTypeAutomaticSavings             PayoutEntryType = "AUTOMATIC_SAVINGS"
+	PayoutEntryTypeAutomaticSavingsReversed     PayoutEntryType = "AUTOMATIC_SAVINGS_REVERSED"
+	PayoutEntryTypeCharge                       PayoutEntryType = "CHARGE"
+	PayoutEntryTypeDepositFee                   PayoutEntryType = "DEPOSIT_FEE"
+	PayoutEntryTypeDepositFeeReversed           PayoutEntryType = "DEPOSIT_FEE_REVERSED"
+	PayoutEntryTypeDispute                      PayoutEntryType = "DISPUTE"
+	PayoutEntryTypeEscheatment                  PayoutEntryType = "ESCHEATMENT"
+	PayoutEntryTypeFee                          PayoutEntryType = "FEE"
+	PayoutEntryTypeFreeProcessing               PayoutEntryType = "FREE_PROCESSING"
+	PayoutEntryTypeHoldAdjustment               PayoutEntryType = "HOLD_ADJUSTMENT"
+	PayoutEntryTypeInitialBalanceChange         PayoutEntryType = "INITIAL_BALANCE_CHANGE"
+	PayoutEntryTypeMoneyTransfer                PayoutEntryType = "MONEY_TRANSFER"
+	PayoutEntryTypeMoneyTransferReversal        PayoutEntryType = "MONEY_TRANSFER_REVERSAL"
+	PayoutEntryTypeOpenDispute                  PayoutEntryType = "OPEN_DISPUTE"
+	PayoutEntryTypeOther                        PayoutEntryType = "OTHER"
+	PayoutEntryTypeOtherAdjustment              PayoutEntryType = "OTHER_ADJUSTMENT"
+	PayoutEntryTypePaidServiceFee               PayoutEntryType = "PAID_SERVICE_FEE"
+	PayoutEntryTypePaidServiceFeeRefund         PayoutEntryType = "PAID_SERVICE_FEE_REFUND"
+	PayoutEntryTypeRedemptionCode               PayoutEntryType = "REDEMPTION_CODE"
+	PayoutEntryTypeRefund                       PayoutEntryType = "REFUND"
+	PayoutEntryTypeReleaseAdjustment            PayoutEntryType = "RELEASE_ADJUSTMENT"
+	PayoutEntryTypeReserveHold                  PayoutEntryType = "RESERVE_HOLD"
+	PayoutEntryTypeReserveRelease               PayoutEntryType = "RESERVE_RELEASE"
+	PayoutEntryTypeReturnedPayout               PayoutEntryType = "RETURNED_PAYOUT"
+	PayoutEntryTypeSquareCapitalPayment         PayoutEntryType = "SQUARE_CAPITAL_PAYMENT"
+	PayoutEntryTypeSquareCapitalReversedPayment PayoutEntryType = "SQUARE_CAPITAL_REVERSED_PAYMENT"
+	PayoutEntryTypeSubscriptionFee              PayoutEntryType = "SUBSCRIPTION_FEE"
+	PayoutEntryTypeSubscriptionFeePaidRefund    PayoutEntryType = "SUBSCRIPTION_FEE_PAID_REFUND"
+	PayoutEntryTypeSubscriptionFeeRefund        PayoutEntryType = "SUBSCRIPTION_FEE_REFUND"
+	PayoutEntryTypeTaxOnFee                     PayoutEntryType = "TAX_ON_FEE"
+	PayoutEntryTypeThirdPartyFee                PayoutEntryType = "THIRD_PARTY_FEE"
+	PayoutEntryTypeThirdPartyFeeRefund          PayoutEntryType = "THIRD_PARTY_FEE_REFUND"
+)
+
+// PayoutService is an interface for interfacing with the Square Payouts API.
+type PayoutService interface {
+	ListPayouts(ctx context.Context, options *ListOptions) (*ListPayouts, *Response, error)
+	GetPayout(ctx context.Context, payoutId string) (*Payout, *Response, error)
+	ListPayoutEntries(ctx context.Context, payoutId string, options *ListOptions) (*ListPayoutLedgerEntries, *Response, error)
+}
+
+var _ PayoutService = &PayoutServiceOp{}
+
+// PayoutServiceOp handles communication with the payout related methods of the Square API.
+type PayoutServiceOp struct {
+	client *Client
+}
+
+// ListPayouts represents a list of payouts.
+type ListPayouts struct {
+	Payouts []PayoutEntry `json:"payouts"`
+	Cursor  string        `json:"cursor,omitempty"`
+}
+
+// Payout represents a payout.
+type Payout struct {
+	Payout *PayoutEntry `json:"payout"`
+}
+
+// PayoutEntry represents a payout sent to a bank account or card.
+type PayoutEntry struct {
+	Id          string             `json:"id"`
+	Status      PayoutStatus       `json:"status,omitempty"`
+	LocationId  string             `json:"location_id"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+	AmountMoney *AmountMoney       `json:"amount_money,omitempty"`
+	Destination *PayoutDestination `json:"destination,omitempty"`
+	Version     int                `json:"version,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	PayoutFee   []PayoutFee        `json:"payout_fee,omitempty"`
+	ArrivalDate string             `json:"arrival_date,omitempty"`
+	EndToEndId  string             `json:"end_to_end_id,omitempty"`
+}
+
+// PayoutDestination represents where a payout was sent to.
+type PayoutDestination struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+// PayoutFee represents a fee charged for a payout.
+type PayoutFee struct {
+	AmountMoney *AmountMoney `json:"amount_money,omitempty"`
+	EffectiveAt time.Time    `json:"effective_at,omitempty"`
+	Type        string       `json:"type,omitempty"`
+}
+
+// ListPayoutLedgerEntries represents a list of payout ledger entries.
+type ListPayoutLedgerEntries struct {
+	PayoutEntries []PayoutLedgerEntry `json:"payout_entries"`
+	Cursor        string              `json:"cursor,omitempty"`
+}
+
+// PayoutLedgerEntry represents a single money movement (charge, refund, fee...) that makes up a payout. Square
+// calls these objects payout entries.
+type PayoutLedgerEntry struct {
+	Id               string          `json:"id"`
+	PayoutId         string          `json:"payout_id"`
+	EffectiveAt      time.Time       `json:"effective_at,omitempty"`
+	Type             PayoutEntryType `json:"type,omitempty"`
+	GrossAmountMoney *AmountMoney    `json:"gross_amount_money,omitempty"`
+	FeeAmountMoney   *AmountMoney    `json:"fee_amount_money,omitempty"`
+	NetAmountMoney   *AmountMoney    `json:"net_amount_money,omitempty"`
+
+	TypeAppFeeRevenueDetails                *PayoutEntryDetails `json:"type_app_fee_revenue_details,omitempty"`
+	TypeAppFeeRefundDetails                 *PayoutEntryDetails `json:"type_app_fee_refund_details,omitempty"`
+	TypeAutomaticSavingsDetails             *PayoutEntryDetails `json:"type_automatic_savings_details,omitempty"`
+	TypeAutomaticSavingsReversedDetails     *PayoutEntryDetails `json:"type_automatic_savings_reversed_details,omitempty"`
+	TypeChargeDetails                       *PayoutEntryDetails `json:"type_charge_details,omitempty"`
+	TypeDepositFeeDetails                   *PayoutEntryDetails `json:"type_deposit_fee_details,omitempty"`
+	TypeDepositFeeReversedDetails           *PayoutEntryDetails `json:"type_deposit_fee_reversed_details,omitempty"`
+	TypeDisputeDetails                      *PayoutEntryDetails `json:"type_dispute_details,omitempty"`
+	TypeFeeDetails                          *PayoutEntryDetails `json:"type_fee_details,omitempty"`
+	TypeFreeProcessingDetails               *PayoutEntryDetails `json:"type_free_processing_details,omitempty"`
+	TypeHoldAdjustmentDetails               *PayoutEntryDetails `json:"type_hold_adjustment_details,omitempty"`
+	TypeOpenDisputeDetails                  *PayoutEntryDetails `json:"type_open_dispute_details,omitempty"`
+	TypeOtherDetails                        *PayoutEntryDetails `json:"type_other_details,omitempty"`
+	TypeOtherAdjustmentDetails              *PayoutEntryDetails `json:"type_other_adjustment_details,omitempty"`
+	TypePaidServiceFeeDetails               *PayoutEntryDetails `json:"type_paid_service_fee_details,omitempty"`
+	TypePaidServiceFeeRefundDetails         *PayoutEntryDetails `json:"type_paid_service_fee_refund_details,omitempty"`
+	TypeRedemptionCodeDetails               *PayoutEntryDetails `json:"type_redemption_code_details,omitempty"`
+	TypeRefundDetails                       *PayoutEntryDetails `json:"type_refund_details,omitempty"`
+	TypeReleaseAdjustmentDetails            *PayoutEntryDetails `json:"type_release_adjustment_details,omitempty"`
+	TypeReserveHoldDetails                  *PayoutEntryDetails `json:"type_reserve_hold_details,omitempty"`
+	TypeReserveReleaseDetails               *PayoutEntryDetails `json:"type_reserve_release_details,omitempty"`
+	TypeSquareCapitalPaymentDetails         *PayoutEntryDetails `json:"type_square_capital_payment_details,omitempty"`
+	TypeSquareCapitalReversedPaymentDetails *PayoutEntryDetails `json:"type_square_capital_reversed_payment_details,omitempty"`
+	TypeTaxOnFeeDetails                     *PayoutEntryDetails `json:"type_tax_on_fee_details,omitempty"`
+	TypeThirdPartyFeeDetails                *PayoutEntryDetails `json:"type_third_party_fee_details,omitempty"`
+	TypeThirdPartyFeeRefundDetails          *PayoutEntryDetails `json:"type_third_party_fee_refund_details,omitempty"`
+}
+
+// PayoutEntryDetails represents the type specific details of a payout ledger entry. Only the fields relevant to
+// the entry type are populated.
+type PayoutEntryDetails struct {
+	PaymentId        string `json:"payment_id,omitempty"`
+	RefundId         string `json:"refund_id,omitempty"`
+	DisputeId        string `json:"dispute_id,omitempty"`
+	LocationId       string `json:"location_id,omitempty"`
+	PayoutId         string `json:"payout_id,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	PartyName        string `json:"party_name,omitempty"`
+	RefundType       string `json:"refund_type,omitempty"`
+	GiftCardId       string `json:"gift_card_id,omitempty"`
+	PaymentType      string `json:"payment_type,omitempty"`
+	SubscriptionType string `json:"subscription_type,omitempty"`
+}
+
+// Details returns the type specific details of the entry, or nil if Square did not send any.
+func (e *PayoutLedgerEntry) Details() *PayoutEntryDetails {
+	for _, d := range []*PayoutEntryDetails{
+		e.TypeChargeDetails,
+		e.TypeRefundDetails,
+		e.TypeFeeDetails,
+		e.TypeOtherAdjustmentDetails,
+		e.TypeAppFeeRevenueDetails,
+		e.TypeAppFeeRefundDetails,
+		e.TypeAutomaticSavingsDetails,
+		e.TypeAutomaticSavingsReversedDetails,
+		e.TypeDepositFeeDetails,
+		e.TypeDepositFeeReversedDetails,
+		e.TypeDisputeDetails,
+		e.TypeFreeProcessingDetails,
+		e.TypeHoldAdjustmentDetails,
+		e.TypeOpenDisputeDetails,
+		e.TypeOtherDetails,
+		e.TypePaidServiceFeeDetails,
+		e.TypePaidServiceFeeRefundDetails,
+		e.TypeRedemptionCodeDetails,
+		e.TypeReleaseAdjustmentDetails,
+		e.TypeReserveHoldDetails,
+		e.TypeReserveReleaseDetails,
+		e.TypeSquareCapitalPaymentDetails,
+		e.TypeSquareCapitalReversedPaymentDetails,
+		e.TypeTaxOnFeeDetails,
+		e.TypeThirdPartyFeeDetails,
+		e.TypeThirdPartyFeeRefundDetails,
+	} {
+		if d != nil {
+			return d
+		}
+	}
+	return nil
+}
+
+// PaymentId returns the ID of the payment the entry relates to, or an empty string if the entry is not tied to a
+// payment (e.g. deposit fees).
+func (e *PayoutLedgerEntry) PaymentId() string {
+	if d := e.Details(); d != nil {
+		return d.PaymentId
+	}
+	return ""
+}
+
+// ListPayouts returns a list of all payouts for the default location, or for the location given in the options.
+func (s *PayoutServiceOp) ListPayouts(ctx context.Context, options *ListOptions) (*ListPayouts, *Response, error) {
+	p, err := addOptions(PayoutBasePath, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListPayouts)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// GetPayout returns a payout by ID.
+func (s *PayoutServiceOp) GetPayout(ctx context.Context, payoutId string) (*Payout, *Response, error) {
+	if len(payoutId) == 0 {
+		return nil, nil, NewArgError("payoutId", "cannot be an empty string")
+	}
+
+	p := path.Join(PayoutBasePath, payoutId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Payout)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListPayoutEntries returns the ledger entries that make up a payout.
+func (s *PayoutServiceOp) ListPayoutEntries(ctx context.Context, payoutId string, options *ListOptions) (*ListPayoutLedgerEntries, *Response, error) {
+	if len(payoutId) == 0 {
+		return nil, nil, NewArgError("payoutId", "cannot be an empty string")
+	}
+
+	p, err := addOptions(path.Join(PayoutBasePath, payoutId, "payout-entries"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListPayoutLedgerEntries)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,175 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	listPayoutsResponse = `
+{
+  "payouts": [
+    {
+      "id": "po_b345d2c7-90b3-4f0b-a2aa-df1def7f8afc",
+      "status": "PAID",
+      "location_id": "L88917AVBK2S5",
+      "created_at": "2022-03-29T16:12:31Z",
+      "updated_at": "2022-03-30T01:07:22.875Z",
+      "amount_money": {
+        "amount": 6259,
+        "currency": "USD"
+      },
+      "destination": {
+        "type": "BANK_ACCOUNT",
+        "id": "ccof:ZPp3oedR3AaEb2RmYXYQ"
+      },
+      "version": 2,
+      "type": "BATCH",
+      "arrival_date": "2022-03-29",
+      "end_to_end_id": "L2100000005"
+    }
+  ],
+  "cursor": "EMPCyStibo64hS8wLayZPp3oedR3AaEb2RmYXYQ"
+}`
+
+	listPayoutEntriesResponse = `
+{
+  "payout_entries": [
+    {
+      "id": "poe_ZQWcw41d0SGJS6IWd4cSi8mKHk",
+      "payout_id": "po_f3c0fb38-a5ce-427d-b858-52b925b72e45",
+      "effective_at": "2021-12-14T23:31:49Z",
+      "type": "REFUND",
+      "gross_amount_money": {
+        "amount": -50,
+        "currency": "USD"
+      },
+      "fee_amount_money": {
+        "amount": -2,
+        "currency": "USD"
+      },
+      "net_amount_money": {
+        "amount": -48,
+        "currency": "USD"
+      },
+      "type_refund_details": {
+        "payment_id": "HVdG62HeMlti8YYf94oxrN",
+        "refund_id": "HVdG62HeMlti8YYf94oxrN_dR8Fdu3hWGyb5xFlPYNDn"
+      }
+    }
+  ]
+}`
+)
+
+func TestPayoutServiceOp_ListPayouts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payouts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{
+			"location_id": "L88917AVBK2S5",
+			"status":      "PAID",
+		})
+		fmt.Fprint(w, listPayoutsResponse)
+	})
+
+	options := &ListOptions{LocationID: "L88917AVBK2S5", Status: string(PayoutStatusPaid)}
+	got, _, err := client.Payout.ListPayouts(ctx, options)
+	if err != nil {
+		t.Fatalf("Payout.ListPayouts returned error: %v", err)
+	}
+
+	expected := &ListPayouts{
+		Payouts: []PayoutEntry{
+			{
+				Id:          "po_b345d2c7-90b3-4f0b-a2aa-df1def7f8afc",
+				Status:      PayoutStatusPaid,
+				LocationId:  "L88917AVBK2S5",
+				CreatedAt:   time.Date(2022, 3, 29, 16, 12, 31, 0, time.UTC),
+				UpdatedAt:   time.Date(2022, 3, 30, 1, 7, 22, 875000000, time.UTC),
+				AmountMoney: &AmountMoney{Amount: 6259, Currency: "USD"},
+				Destination: &PayoutDestination{Type: "BANK_ACCOUNT", Id: "ccof:ZPp3oedR3AaEb2RmYXYQ"},
+				Version:     2,
+				Type:        "BATCH",
+				ArrivalDate: "2022-03-29",
+				EndToEndId:  "L2100000005",
+			},
+		},
+		Cursor: "EMPCyStibo64hS8wLayZPp3oedR3AaEb2RmYXYQ",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Payout.ListPayouts returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestPayoutServiceOp_GetPayout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payouts/po_b345d2c7-90b3-4f0b-a2aa-df1def7f8afc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"payout":{"id":"po_b345d2c7-90b3-4f0b-a2aa-df1def7f8afc","status":"FAILED","location_id":"L88917AVBK2S5"}}`)
+	})
+
+	got, _, err := client.Payout.GetPayout(ctx, "po_b345d2c7-90b3-4f0b-a2aa-df1def7f8afc")
+	if err != nil {
+		t.Fatalf("Payout.GetPayout returned error: %v", err)
+	}
+
+	expected := &Payout{Payout: &PayoutEntry{
+		Id:         "po_b345d2c7-90b3-4f0b-a2aa-df1def7f8afc",
+		Status:     PayoutStatusFailed,
+		LocationId: "L88917AVBK2S5",
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Payout.GetPayout returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Payout.GetPayout(ctx, ""); err == nil {
+		t.Error("Payout.GetPayout with an empty ID returned no error")
+	}
+}
+
+func TestPayoutServiceOp_ListPayoutEntries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/payouts/po_f3c0fb38-a5ce-427d-b858-52b925b72e45/payout-entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"limit": "10"})
+		fmt.Fprint(w, listPayoutEntriesResponse)
+	})
+
+	got, _, err := client.Payout.ListPayoutEntries(ctx, "po_f3c0fb38-a5ce-427d-b858-52b925b72e45", &ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("Payout.ListPayoutEntries returned error: %v", err)
+	}
+
+	expected := &ListPayoutLedgerEntries{
+		PayoutEntries: []PayoutLedgerEntry{
+			{
+				Id:               "poe_ZQWcw41d0SGJS6IWd4cSi8mKHk",
+				PayoutId:         "po_f3c0fb38-a5ce-427d-b858-52b925b72e45",
+				EffectiveAt:      time.Date(2021, 12, 14, 23, 31, 49, 0, time.UTC),
+				Type:             PayoutEntryTypeRefund,
+				GrossAmountMoney: &AmountMoney{Amount: -50, Currency: "USD"},
+				FeeAmountMoney:   &AmountMoney{Amount: -2, Currency: "USD"},
+				NetAmountMoney:   &AmountMoney{Amount: -48, Currency: "USD"},
+				TypeRefundDetails: &PayoutEntryDetails{
+					PaymentId: "HVdG62HeMlti8YYf94oxrN",
+					RefundId:  "HVdG62HeMlti8YYf94oxrN_dR8Fdu3hWGyb5xFlPYNDn",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Payout.ListPayoutEntries returned %+v, expected %+v", got, expected)
+	}
+	if id := got.PayoutEntries[0].PaymentId(); id != "HVdG62HeMlti8YYf94oxrN" {
+		t.Errorf("PayoutLedgerEntry.PaymentId() = %q, expected %q", id, "HVdG62HeMlti8YYf94oxrN")
+	}
+}
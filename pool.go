@@ -0,0 +1,85 @@
+package squareup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TokenProvider resolves the access token of a merchant. Implementations typically look the token up in a
+// datastore, and may refresh it when it is about to expire.
+type TokenProvider interface {
+	Token(ctx context.Context, merchantId string) (string, error)
+}
+
+// TokenProviderFunc is an adapter to allow the use of ordinary functions as a TokenProvider.
+type TokenProviderFunc func(ctx context.Context, merchantId string) (string, error)
+
+// Token calls f(ctx, merchantId).
+func (f TokenProviderFunc) Token(ctx context.Context, merchantId string) (string, error) {
+	return f(ctx, merchantId)
+}
+
+// merchantTokenSource is a TokenSource bound to a single merchant of a TokenProvider.
+type merchantTokenSource struct {
+	provider   TokenProvider
+	merchantId string
+}
+
+// Token returns the access token of the merchant, or an error if the provider has none.
+func (s *merchantTokenSource) Token(ctx context.Context) (string, error) {
+	token, err := s.provider.Token(ctx, s.merchantId)
+	if err != nil {
+		return "", err
+	}
+	if len(token) == 0 {
+		return "", NewArgError("token", fmt.Sprintf("no access token for merchant %s", s.merchantId))
+	}
+	return token, nil
+}
+
+// ClientPool hands out per-merchant clients that share one HTTP client and configuration, so connections are
+// reused across merchants. The clients are lightweight views of a single base client which differ only in the
+// token authorizing their requests, so the pool keeps no per-merchant state. It is safe for concurrent use.
+type ClientPool struct {
+	provider TokenProvider
+	base     *Client
+}
+
+// NewClientPool creates a pool of merchant clients. The opts are applied to the base client shared by every
+// client handed out by the pool.
+func NewClientPool(httpClient *http.Client, mode Mode, provider TokenProvider, opts ...ClientOpt) (*ClientPool, error) {
+	if provider == nil {
+		return nil, NewArgError("provider", "cannot be nil")
+	}
+
+	base, err := New(httpClient, mode, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientPool{provider: provider, base: base}, nil
+}
+
+// ForMerchant returns a client acting on behalf of the given merchant. Its requests are authorized with the
+// token resolved by the pool's TokenProvider at request time.
+func (p *ClientPool) ForMerchant(merchantId string) (*Client, error) {
+	if len(merchantId) == 0 {
+		return nil, NewArgError("merchantId", "cannot be an empty string")
+	}
+
+	c := *p.base
+	c.tokenSource = &merchantTokenSource{provider: p.provider, merchantId: merchantId}
+	c.cacheMerchant = &cacheMerchant{id: merchantId}
+	c.initServices()
+
+	return &c, nil
+}
+
+// ForEvent returns the client of the merchant a webhook event was sent for.
+func (p *ClientPool) ForEvent(e *Event) (*Client, error) {
+	if e == nil {
+		return nil, NewArgError("event", "cannot be nil")
+	}
+	return p.ForMerchant(e.MerchantID)
+}
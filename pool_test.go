@@ -0,0 +1,83 @@
+package squareup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClientPool_ForEvent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tokens := map[string]string{
+		"MERCHANT_A": "token-a",
+		"MERCHANT_B": "token-b",
+	}
+	provider := TokenProviderFunc(func(ctx context.Context, merchantId string) (string, error) {
+		return tokens[merchantId], nil
+	})
+
+	pool, err := NewClientPool(nil, ModeSandbox, provider, SetBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClientPool(): %v", err)
+	}
+
+	var gotAuth string
+	mux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"payments":[]}`)
+	})
+
+	for merchantId, token := range tokens {
+		c, err := pool.ForEvent(&Event{MerchantID: merchantId})
+		if err != nil {
+			t.Fatalf("ClientPool.ForEvent(): %v", err)
+		}
+
+		if _, _, err := c.Payment.ListPayment(ctx, nil); err != nil {
+			t.Fatalf("Payment.ListPayment returned error: %v", err)
+		}
+		if expected := "Bearer " + token; gotAuth != expected {
+			t.Errorf("Authorization header = %v, expected %v", gotAuth, expected)
+		}
+
+		if c.HTTPClient != http.DefaultClient || c.BaseURL != pool.base.BaseURL {
+			t.Errorf("pooled client does not share the configuration of the pool")
+		}
+		if c.Payment.(*PaymentServiceOp).client != c {
+			t.Errorf("pooled client services are bound to another client")
+		}
+	}
+
+	if _, err := pool.ForMerchant(""); err == nil {
+		t.Errorf("ClientPool.ForMerchant(\"\") expected an error")
+	}
+}
+
+func TestClientPool_ForMerchant_emptyToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	provider := TokenProviderFunc(func(ctx context.Context, merchantId string) (string, error) {
+		return "", nil
+	})
+
+	pool, err := NewClientPool(nil, ModeSandbox, provider, SetBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClientPool(): %v", err)
+	}
+
+	mux.HandleFunc("/v2/payments", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("request sent without an access token")
+	})
+
+	c, err := pool.ForMerchant("MERCHANT_A")
+	if err != nil {
+		t.Fatalf("ClientPool.ForMerchant(): %v", err)
+	}
+	if _, _, err := c.Payment.ListPayment(ctx, nil); err == nil {
+		t.Errorf("Payment.ListPayment with an empty token expected an error")
+	}
+}
@@ -0,0 +1,124 @@
+package squareup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RequestOption customizes a single API call, e.g. by setting a header or a timeout.
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the options of a single API call.
+type requestOptions struct {
+	headers        map[string]string
+	timeout        time.Duration
+	idempotencyKey string
+	version        string
+	skipValidation bool
+	skipCache      bool
+}
+
+// requestOptionsKey is the context key of the request options set by WithRequestOptions.
+type requestOptionsKey struct{}
+
+// WithHeader sets an HTTP header on the request, overriding client wide headers of the same name.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = make(map[string]string)
+		}
+		o.headers[key] = value
+	}
+}
+
+// WithTimeout bounds the time the call may take, including reading the response.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = d
+	}
+}
+
+// WithIdempotencyKey sets the idempotency_key of the request body, overriding the key of the request model.
+// Requests whose body is not a JSON object, e.g. requests without a body, fail with an *ArgError instead.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// WithVersion sets the API version of the request, overriding the SquareVersion of the client. Like other
+// options, the last version set on a context takes precedence.
+func WithVersion(version string) RequestOption {
+	return func(o *requestOptions) {
+		o.version = version
+	}
+}
+
+// WithRequestOptions returns a copy of ctx carrying opts, which are honoured by NewRequest and Do for requests
+// made with it. Options are added to those already carried by ctx, later options taking precedence.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	o := requestOptionsFrom(ctx)
+	if o.headers != nil {
+		headers := make(map[string]string, len(o.headers))
+		for k, v := range o.headers {
+			headers[k] = v
+		}
+		o.headers = headers
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, o)
+}
+
+// withoutRequestOptions returns a copy of ctx carrying no request options and no cached resource, for requests
+// made on behalf of another one, such as token refreshes. Cancellation and deadlines of ctx still apply.
+func withoutRequestOptions(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, requestOptionsKey{}, requestOptions{})
+	return context.WithValue(ctx, cacheKeyKey{}, nil)
+}
+
+// requestOptionsFrom returns the request options carried by ctx.
+func requestOptionsFrom(ctx context.Context) requestOptions {
+	o, _ := ctx.Value(requestOptionsKey{}).(requestOptions)
+	return o
+}
+
+// NewIdempotencyKey returns a random version 4 UUID, suitable as the idempotency key of a request.
+func NewIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// setIdempotencyKey sets the idempotency_key field of the JSON object in buf to key. It returns an *ArgError if
+// buf does not hold a JSON object, as the key could not be sent.
+func setIdempotencyKey(buf *bytes.Buffer, key string) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil || fields == nil {
+		return NewArgError("idempotencyKey", "cannot be set on a request body which is not a JSON object")
+	}
+
+	raw, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	fields["idempotency_key"] = raw
+
+	buf.Reset()
+	return json.NewEncoder(buf).Encode(fields)
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"github.com/google/go-querystring/query"
 	"io"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"reflect"
 	"strings"
@@ -41,17 +43,61 @@ type Client struct {
 	// User agent used when communicating with the Square API.
 	UserAgent string
 
+	// SquareVersion is the API version sent in the Square-Version header of every request. It can be overridden
+	// per request with WithVersion.
+	SquareVersion string
+
 	// Services used for talking to different parts of the Square API.
-	TerminalAction TerminalActionService
-	Terminal       TerminalCheckoutService
-	TerminalRefund TerminalRefundService
-	Payment        PaymentService
+	TerminalAction          TerminalActionService
+	Terminal                TerminalCheckoutService
+	TerminalRefund          TerminalRefundService
+	Payment                 PaymentService
+	Dispute                 DisputeService
+	Payout                  PayoutService
+	OAuth                   OAuthService
+	Checkout                CheckoutService
+	GiftCard                GiftCardService
+	GiftCardActivity        GiftCardActivityService
+	Loyalty                 LoyaltyService
+	Team                    TeamService
+	Labor                   LaborService
+	Booking                 BookingService
+	Webhook                 WebhookSubscriptionService
+	Merchant                MerchantService
+	BankAccount             BankAccountService
+	CustomerGroup           CustomerGroupService
+	CustomerSegment         CustomerSegmentService
+	CustomerCustomAttribute CustomerCustomAttributeService
+	OrderCustomAttribute    CustomAttributeService[OrderBulkCustomAttribute]
+	LocationCustomAttribute CustomAttributeService[LocationBulkCustomAttribute]
+	MerchantCustomAttribute CustomAttributeService[MerchantBulkCustomAttribute]
+	BookingCustomAttribute  CustomAttributeService[BookingBulkCustomAttribute]
+	CashDrawer              CashDrawerService
+	Vendor                  VendorService
+	Location                LocationService
+	Device                  DeviceService
+	Catalog                 CatalogService
 
 	// Optional function called after every successful request made to the DO APIs
 	onRequestCompleted RequestCompletionCallback
 
 	// Optional extra HTTP headers to set on every request to the API.
 	headers map[string]string
+
+	// Optional source of the access token used to authorize requests, overriding the Authorization header.
+	tokenSource TokenSource
+
+	// Whether NewRequest skips the validation of request models.
+	skipValidation bool
+
+	// Optional limiter pacing the requests made by Do.
+	limiter Limiter
+
+	// Optional cache of the responses of read endpoints, the merchant the entries of the client are kept under and
+	// the API versions they were read with.
+	cache         Cache
+	cacheMerchant *cacheMerchant
+	cacheVersions *cacheVersions
 }
 
 // RequestCompletionCallback defines the type of the request callback function
@@ -86,6 +132,45 @@ type ListOptions struct {
 	// CardBrand is the brand of the payment card (e.g., VISA).
 	CardBrand string `url:"card_brand,omitempty"`
 
+	// States is the list of dispute states used to filter the result.
+	States []string `url:"states,omitempty,comma"`
+
+	// Status is the status used to filter the result (e.g. payout status).
+	Status string `url:"status,omitempty"`
+
+	// Type is the type used to filter the result (e.g. gift card or gift card activity type).
+	Type string `url:"type,omitempty"`
+
+	// State is the state used to filter the result (e.g. gift card state).
+	State string `url:"state,omitempty"`
+
+	// CustomerID is the ID of the customer used to filter the result.
+	CustomerID string `url:"customer_id,omitempty"`
+
+	// GiftCardID is the ID of the gift card used to filter gift card activities.
+	GiftCardID string `url:"gift_card_id,omitempty"`
+
+	// TeamMemberID is the ID of the team member used to filter the result.
+	TeamMemberID string `url:"team_member_id,omitempty"`
+
+	// StartAtMin is the earliest start time of bookings to list, in RFC 3339 format.
+	StartAtMin string `url:"start_at_min,omitempty"`
+
+	// StartAtMax is the latest start time of bookings to list, in RFC 3339 format.
+	StartAtMax string `url:"start_at_max,omitempty"`
+
+	// BookableOnly limits team member booking profiles to team members who can be booked.
+	BookableOnly bool `url:"bookable_only,omitempty"`
+
+	// IncludeDisabled includes disabled webhook subscriptions in the result.
+	IncludeDisabled bool `url:"include_disabled,omitempty"`
+
+	// VisibilityFilter filters custom attributes and definitions by visibility. One of ALL or READ or READ_WRITE.
+	VisibilityFilter string `url:"visibility_filter,omitempty"`
+
+	// WithDefinitions includes the definition of each listed custom attribute in the result.
+	WithDefinitions bool `url:"with_definitions,omitempty"`
+
 	// Query Body
 	Body interface{} `url:"-"`
 }
@@ -108,6 +193,25 @@ type ErrorResponse struct {
 
 	// RequestID is the unique identifier for the request
 	RequestID string `json:"request_id"`
+
+	// Errors are the errors returned by the API
+	Errors []APIError `json:"errors"`
+}
+
+// Is reports whether the error response matches target, i.e. ErrVersionMismatch when the API returned a
+// VERSION_MISMATCH error.
+func (r *ErrorResponse) Is(target error) bool {
+	return target == ErrVersionMismatch && r.HasErrorCode(ErrorCodeVersionMismatch)
+}
+
+// HasErrorCode reports whether the API returned an error with the given code, e.g. ErrorCodeVersionMismatch.
+func (r *ErrorResponse) HasErrorCode(code string) bool {
+	for _, e := range r.Errors {
+		if e.Code == code {
+			return true
+		}
+	}
+	return false
 }
 
 // addOptions adds the parameters in opt as URL query parameters to s. opt must be a struct whose fields contain tags
@@ -167,18 +271,49 @@ func NewClient(httpClient *http.Client, mode Mode) *Client {
 	}
 
 	c := &Client{
-		HTTPClient: httpClient,
-		BaseURL:    baseURL,
-		UserAgent:  userAgent,
+		HTTPClient:    httpClient,
+		BaseURL:       baseURL,
+		UserAgent:     userAgent,
+		SquareVersion: libraryVersion,
 	}
 
 	c.headers = make(map[string]string)
+	c.initServices()
 
+	return c
+}
+
+// initServices points the services of c at c.
+func (c *Client) initServices() {
 	c.TerminalAction = &TerminalActionServiceOp{client: c}
 	c.Terminal = &TerminalCheckoutServiceOp{client: c}
+	c.TerminalRefund = &TerminalRefundServiceOp{client: c}
 	c.Payment = &PaymentServiceOp{client: c}
-
-	return c
+	c.Dispute = &DisputeServiceOp{client: c}
+	c.Payout = &PayoutServiceOp{client: c}
+	c.OAuth = &OAuthServiceOp{client: c}
+	c.Checkout = &CheckoutServiceOp{client: c}
+	c.GiftCard = &GiftCardServiceOp{client: c}
+	c.GiftCardActivity = &GiftCardActivityServiceOp{client: c}
+	c.Loyalty = &LoyaltyServiceOp{client: c}
+	c.Team = &TeamServiceOp{client: c}
+	c.Labor = &LaborServiceOp{client: c}
+	c.Booking = &BookingServiceOp{client: c}
+	c.Webhook = &WebhookSubscriptionServiceOp{client: c}
+	c.Merchant = &MerchantServiceOp{client: c}
+	c.BankAccount = &BankAccountServiceOp{client: c}
+	c.CustomerGroup = &CustomerGroupServiceOp{client: c}
+	c.CustomerSegment = &CustomerSegmentServiceOp{client: c}
+	c.CustomerCustomAttribute = &CustomAttributeServiceOp[CustomerBulkCustomAttribute]{client: c, basePath: CustomerBasePath, upsertMethod: http.MethodPost}
+	c.OrderCustomAttribute = &CustomAttributeServiceOp[OrderBulkCustomAttribute]{client: c, basePath: OrderBasePath, upsertMethod: http.MethodPost}
+	c.LocationCustomAttribute = &CustomAttributeServiceOp[LocationBulkCustomAttribute]{client: c, basePath: LocationBasePath, upsertMethod: http.MethodPost}
+	c.MerchantCustomAttribute = &CustomAttributeServiceOp[MerchantBulkCustomAttribute]{client: c, basePath: MerchantBasePath, upsertMethod: http.MethodPost}
+	c.BookingCustomAttribute = &CustomAttributeServiceOp[BookingBulkCustomAttribute]{client: c, basePath: BookingBasePath, upsertMethod: http.MethodPut}
+	c.CashDrawer = &CashDrawerServiceOp{client: c}
+	c.Vendor = &VendorServiceOp{client: c}
+	c.Location = &LocationServiceOp{client: c}
+	c.Device = &DeviceServiceOp{client: c}
+	c.Catalog = &CatalogServiceOp{client: c}
 }
 
 // ClientOpt are options for New.
@@ -235,10 +370,42 @@ func SetAuthToken(token string) ClientOpt {
 	}
 }
 
+// SetSquareVersion is a client option for pinning the API version sent with every request.
+func SetSquareVersion(version string) ClientOpt {
+	return func(c *Client) error {
+		if len(version) == 0 {
+			return NewArgError("version", "cannot be an empty string")
+		}
+
+		c.SquareVersion = version
+		return nil
+	}
+}
+
+// WithSquareVersion returns a copy of ctx which makes requests created with it use the given API version instead
+// of the SquareVersion of the client. It is shorthand for WithRequestOptions(ctx, WithVersion(version)).
+func WithSquareVersion(ctx context.Context, version string) context.Context {
+	return WithRequestOptions(ctx, WithVersion(version))
+}
+
+// SetTokenSource sets the source of the access token sent on each HTTP request. Use it with a
+// RefreshTokenSource to refresh expiring OAuth tokens before requests are made.
+func SetTokenSource(ts TokenSource) ClientOpt {
+	return func(c *Client) error {
+		c.tokenSource = ts
+		return nil
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
-// value pointed to by body is JSON encoded and included in as the request body.
+// value pointed to by body is JSON encoded and included in as the request body. Bodies implementing Validator are
+// validated first, unless validation is disabled.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	if err := c.validate(ctx, body); err != nil {
+		return nil, err
+	}
+
 	u, err := c.BaseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
@@ -261,22 +428,130 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 			}
 		}
 
+		if key := requestOptionsFrom(ctx).idempotencyKey; len(key) > 0 {
+			if err = setIdempotencyKey(buf, key); err != nil {
+				return nil, err
+			}
+		}
+
 		req, err = http.NewRequest(method, u.String(), buf)
 		if err != nil {
 			return nil, err
 		}
 		req.Header.Set("Content-Type", mediaType)
-		req.Header.Set("Square-Version", libraryVersion)
 	}
 
+	if err = c.setHeaders(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadRequest creates a multipart API request. The value pointed to by body is JSON encoded into the "request"
+// part and the content of r is attached as a file part named field, using the given file name and content type.
+// An idempotency key set with request options is set in the "request" part. Bodies implementing Validator are
+// validated first, unless validation is disabled.
+func (c *Client) NewUploadRequest(ctx context.Context, urlStr string, body interface{}, field, filename, contentType string, r io.Reader) (*http.Request, error) {
+	if err := c.validate(ctx, body); err != nil {
+		return nil, err
+	}
+
+	u, err := c.BaseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	request := new(bytes.Buffer)
+	if body != nil {
+		if err = json.NewEncoder(request).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+	if key := requestOptionsFrom(ctx).idempotencyKey; len(key) > 0 {
+		if err = setIdempotencyKey(request, key); err != nil {
+			return nil, err
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	mw := multipart.NewWriter(buf)
+
+	if request.Len() > 0 {
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", `form-data; name="request"`)
+		h.Set("Content-Type", mediaType)
+		part, err := mw.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.Copy(part, request); err != nil {
+			return nil, err
+		}
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, field, filename))
+	h.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(part, r); err != nil {
+		return nil, err
+	}
+
+	if err = mw.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	if err = c.setHeaders(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// setHeaders sets the client wide headers on req, including the Authorization header obtained from the token
+// source if one is set, and the Square-Version header. Headers and version set with request options on ctx take
+// precedence.
+func (c *Client) setHeaders(ctx context.Context, req *http.Request) error {
 	for k, v := range c.headers {
 		req.Header.Add(k, v)
 	}
 
+	if skip, _ := ctx.Value(noTokenSourceKey{}).(bool); c.tokenSource != nil && !skip {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	opts := requestOptionsFrom(ctx)
+
+	version := c.SquareVersion
+	if len(opts.version) > 0 {
+		version = opts.version
+	}
+	if len(version) > 0 {
+		req.Header.Set(headerSquareVersion, version)
+	}
+
+	for k, v := range opts.headers {
+		req.Header.Set(k, v)
+	}
+
 	req.Header.Set("Accept", mediaType)
 	req.Header.Set("User-Agent", c.UserAgent)
 
-	return req, nil
+	return nil
 }
 
 // OnRequestCompleted sets the DO API request completion callback
@@ -286,8 +561,29 @@ func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
+// the raw response will be written to v, without attempting to decode it. A timeout set with request options on
+// ctx applies to the whole call.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if timeout := requestOptionsFrom(ctx).timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	_, isWriter := v.(io.Writer)
+	cached, cacheable := c.cachedRequest(ctx)
+	cacheable = cacheable && v != nil && !isWriter
+	if cacheable && req.Method == http.MethodGet && !requestOptionsFrom(ctx).skipCache {
+		if data, ok := c.cache.Get(cached.key()); ok && json.Unmarshal(data, v) == nil {
+			return cachedResponse(req), nil
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
 
 	resp, err := DoRequestWithClient(ctx, c.HTTPClient, req)
 	if err != nil {
@@ -321,6 +617,10 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		return response, err
 	}
 
+	if cacheable && req.Method != http.MethodGet {
+		c.evict(cached.namespace, cached.resource)
+	}
+
 	if resp.StatusCode != http.StatusNoContent && v != nil {
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
@@ -328,10 +628,18 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 				return nil, err
 			}
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
+			data, err := io.ReadAll(resp.Body)
 			if err != nil {
 				return nil, err
 			}
+			if err = json.Unmarshal(data, v); err != nil {
+				return nil, err
+			}
+			response.Meta.setCursor(data)
+
+			if cacheable && req.Method == http.MethodGet {
+				c.cache.Set(cached.key(), data)
+			}
 		}
 	}
 
@@ -340,7 +648,7 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 // newResponse creates a new Response for the provided http.Response
 func newResponse(r *http.Response) *Response {
-	response := Response{Response: r}
+	response := Response{Response: r, Meta: newMeta(r)}
 
 	return &response
 }
@@ -362,12 +670,22 @@ func DoRequestWithClient(
 // Error returns the error message for the ErrorResponse.
 func (r *ErrorResponse) Error() string {
 	var attempted string
+
+	message := r.Message
+	if message == "" && len(r.Errors) > 0 {
+		msgs := make([]string, len(r.Errors))
+		for i := range r.Errors {
+			msgs[i] = r.Errors[i].Error()
+		}
+		message = strings.Join(msgs, "; ")
+	}
+
 	if r.RequestID != "" {
 		return fmt.Sprintf("%v %v: %d (request %q) %v%s",
-			r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.RequestID, r.Message, attempted)
+			r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.RequestID, message, attempted)
 	}
 	return fmt.Sprintf("%v %v: %d %v%s",
-		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message, attempted)
+		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, message, attempted)
 }
 
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered an
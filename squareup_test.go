@@ -2,12 +2,15 @@ package squareup
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 var (
@@ -27,6 +30,11 @@ func setup() {
 	client = NewClient(nil, ModeSandbox)
 	url, _ := url.Parse(server.URL)
 	client.BaseURL = url
+
+	// Run the suite against another API version with e.g. SQUARE_VERSION=2024-07-17 go test ./...
+	if v := os.Getenv("SQUARE_VERSION"); v != "" {
+		client.SquareVersion = v
+	}
 }
 
 func teardown() {
@@ -39,6 +47,18 @@ func testMethod(t *testing.T, r *http.Request, expected string) {
 	}
 }
 
+// testJSONBody decodes the JSON body of r into v, a pointer to a new value, and compares it with expected.
+func testJSONBody(t *testing.T, r *http.Request, v, expected interface{}) {
+	t.Helper()
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		t.Fatalf("Decode(): %v", err)
+	}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("Request body = %+v, expected %+v", v, expected)
+	}
+}
+
 type values map[string]string
 
 func testFormValues(t *testing.T, r *http.Request, values values) {
@@ -69,6 +89,30 @@ func testURLParseError(t *testing.T, err error) {
 func testClientServices(t *testing.T, c *Client) {
 	services := []string{
 		"TerminalAction",
+		"Terminal",
+		"TerminalRefund",
+		"Dispute",
+		"Payout",
+		"OAuth",
+		"Checkout",
+		"GiftCard",
+		"GiftCardActivity",
+		"Loyalty",
+		"Team",
+		"Labor",
+		"Booking",
+		"Webhook",
+		"Merchant",
+		"BankAccount",
+		"CustomerGroup",
+		"CustomerSegment",
+		"CustomerCustomAttribute",
+		"OrderCustomAttribute",
+		"LocationCustomAttribute",
+		"MerchantCustomAttribute",
+		"BookingCustomAttribute",
+		"CashDrawer",
+		"Vendor",
 	}
 	cp := reflect.ValueOf(c)
 	cv := reflect.Indirect(cp)
@@ -202,3 +246,93 @@ func TestCustomBaseURL_badURL(t *testing.T) {
 
 	testURLParseError(t, err)
 }
+
+func TestDo_meta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestID, "c3f4a3c0-0d2b-4f49-9a4f-5b5e3f1d6a7e")
+		w.Header().Set(headerSquareVersion, "2024-05-15")
+		w.Header().Set(headerDeprecation, "true")
+		w.Header().Set(headerSunset, "Wed, 01 Oct 2025 00:00:00 GMT")
+		w.Header().Set(headerRateLimitRemaining, "42")
+		w.Header().Set(headerRetryAfter, "3")
+		fmt.Fprint(w, `{"items":[],"cursor":"next-page"}`)
+	})
+
+	req, _ := client.NewRequest(ctx, http.MethodGet, "foo", nil)
+	resp, err := client.Do(ctx, req, &struct {
+		Items []string `json:"items"`
+	}{})
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	sunset := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	expected := &Meta{
+		Cursor:             "next-page",
+		RequestID:          "c3f4a3c0-0d2b-4f49-9a4f-5b5e3f1d6a7e",
+		SquareVersion:      "2024-05-15",
+		Deprecation:        "true",
+		Sunset:             &sunset,
+		RateLimitRemaining: 42,
+		RetryAfter:         3 * time.Second,
+	}
+	if !reflect.DeepEqual(resp.Meta, expected) {
+		t.Errorf("Do() Meta = %+v, expected %+v", resp.Meta, expected)
+	}
+}
+
+func TestDo_metaNumericCursor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/merchants", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"merchant":[{"id":"DM7VKY8Q63GNP","country":"US"}],"cursor":2}`)
+	})
+
+	_, resp, err := client.Merchant.ListMerchants(ctx, nil)
+	if err != nil {
+		t.Fatalf("Merchant.ListMerchants returned error: %v", err)
+	}
+	if resp.Meta.Cursor != "2" {
+		t.Errorf("Meta.Cursor = %q, expected %q", resp.Meta.Cursor, "2")
+	}
+}
+
+func TestNewRequest_squareVersion(t *testing.T) {
+	c, err := New(nil, ModeSandbox, SetSquareVersion("2024-07-17"))
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		req, _ := c.NewRequest(ctx, method, "/foo", nil)
+		if got := req.Header.Get(headerSquareVersion); got != "2024-07-17" {
+			t.Errorf("NewRequest(%s) Square-Version = %v, expected %v", method, got, "2024-07-17")
+		}
+	}
+
+	req, _ := c.NewRequest(WithSquareVersion(ctx, "2023-12-13"), http.MethodGet, "/foo", nil)
+	if got := req.Header.Get(headerSquareVersion); got != "2023-12-13" {
+		t.Errorf("NewRequest() with overridden version Square-Version = %v, expected %v", got, "2023-12-13")
+	}
+
+	// The version set last takes precedence, whichever way it is set.
+	versionCtx := WithRequestOptions(WithSquareVersion(ctx, "2023-12-13"), WithVersion("2024-01-18"))
+	req, _ = c.NewRequest(versionCtx, http.MethodGet, "/foo", nil)
+	if got := req.Header.Get(headerSquareVersion); got != "2024-01-18" {
+		t.Errorf("NewRequest() with WithVersion last Square-Version = %v, expected %v", got, "2024-01-18")
+	}
+	req, _ = c.NewRequest(WithSquareVersion(versionCtx, "2023-12-13"), http.MethodGet, "/foo", nil)
+	if got := req.Header.Get(headerSquareVersion); got != "2023-12-13" {
+		t.Errorf("NewRequest() with WithSquareVersion last Square-Version = %v, expected %v", got, "2023-12-13")
+	}
+}
+
+func TestSetSquareVersion_empty(t *testing.T) {
+	if _, err := New(nil, ModeSandbox, SetSquareVersion("")); err == nil {
+		t.Error("New() with empty Square-Version returned no error")
+	}
+}
@@ -0,0 +1,383 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	TeamMemberBasePath = "v2/team-members"
+
+	teamMemberJobsPath        = "jobs"
+	teamMemberWageSettingPath = "wage-setting"
+)
+
+// Team member statuses.
+const (
+	TeamMemberStatusActive   = "ACTIVE"
+	TeamMemberStatusInactive = "INACTIVE"
+)
+
+// TeamService is an interface for interfacing with the Square Team API.
+type TeamService interface {
+	CreateTeamMember(ctx context.Context, member *CreateTeamMember) (*TeamMember, *Response, error)
+	BulkCreateTeamMembers(ctx context.Context, members *BulkCreateTeamMembers) (*BulkTeamMembers, *Response, error)
+	UpdateTeamMember(ctx context.Context, teamMemberId string, member *TeamMemberEntry) (*TeamMember, *Response, error)
+	RetrieveTeamMember(ctx context.Context, teamMemberId string) (*TeamMember, *Response, error)
+	SearchTeamMembers(ctx context.Context, search *SearchTeamMembers) (*ListTeamMembers, *Response, error)
+
+	RetrieveWageSetting(ctx context.Context, teamMemberId string) (*WageSetting, *Response, error)
+	UpdateWageSetting(ctx context.Context, teamMemberId string, setting *WageSettingEntry) (*WageSetting, *Response, error)
+
+	ListJobs(ctx context.Context, options *ListOptions) (*ListJobs, *Response, error)
+	CreateJob(ctx context.Context, job *CreateJob) (*Job, *Response, error)
+	RetrieveJob(ctx context.Context, jobId string) (*Job, *Response, error)
+	UpdateJob(ctx context.Context, jobId string, job *JobEntry) (*Job, *Response, error)
+}
+
+var _ TeamService = &TeamServiceOp{}
+
+// TeamServiceOp handles communication with the team related methods of the Square API.
+type TeamServiceOp struct {
+	client *Client
+}
+
+// ListTeamMembers represents a list of team members.
+type ListTeamMembers struct {
+	TeamMembers []TeamMemberEntry `json:"team_members"`
+	Cursor      string            `json:"cursor,omitempty"`
+}
+
+// TeamMember represents a team member.
+type TeamMember struct {
+	TeamMember *TeamMemberEntry `json:"team_member"`
+}
+
+// TeamMemberEntry represents a member of the staff of a seller.
+type TeamMemberEntry struct {
+	Id                string                       `json:"id,omitempty"`
+	ReferenceId       string                       `json:"reference_id,omitempty"`
+	IsOwner           bool                         `json:"is_owner,omitempty"`
+	Status            string                       `json:"status,omitempty"`
+	GivenName         string                       `json:"given_name,omitempty"`
+	FamilyName        string                       `json:"family_name,omitempty"`
+	EmailAddress      string                       `json:"email_address,omitempty"`
+	PhoneNumber       string                       `json:"phone_number,omitempty"`
+	CreatedAt         *time.Time                   `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time                   `json:"updated_at,omitempty"`
+	AssignedLocations *TeamMemberAssignedLocations `json:"assigned_locations,omitempty"`
+	WageSetting       *WageSettingEntry            `json:"wage_setting,omitempty"`
+}
+
+// TeamMemberAssignedLocations represents the locations a team member is assigned to.
+type TeamMemberAssignedLocations struct {
+	AssignmentType string   `json:"assignment_type,omitempty"`
+	LocationIds    []string `json:"location_ids,omitempty"`
+}
+
+// CreateTeamMember represents a team member to be created.
+type CreateTeamMember struct {
+	IdempotencyKey string           `json:"idempotency_key,omitempty"`
+	TeamMember     *TeamMemberEntry `json:"team_member"`
+}
+
+// UpdateTeamMember represents the changes to a team member.
+type UpdateTeamMember struct {
+	TeamMember *TeamMemberEntry `json:"team_member"`
+}
+
+// BulkCreateTeamMembers represents team members to be created, keyed by a client generated key.
+type BulkCreateTeamMembers struct {
+	TeamMembers map[string]TeamMember `json:"team_members"`
+}
+
+// BulkTeamMembers represents the result of a bulk team member operation, keyed like the request.
+type BulkTeamMembers struct {
+	TeamMembers map[string]BulkTeamMemberResult `json:"team_members"`
+	Errors      []APIError                      `json:"errors,omitempty"`
+}
+
+// BulkTeamMemberResult represents the result of one team member of a bulk operation.
+type BulkTeamMemberResult struct {
+	TeamMember *TeamMemberEntry `json:"team_member,omitempty"`
+	Errors     []APIError       `json:"errors,omitempty"`
+}
+
+// SearchTeamMembers represents a team member search.
+type SearchTeamMembers struct {
+	Query struct {
+		Filter struct {
+			LocationIds []string `json:"location_ids,omitempty"`
+			Status      string   `json:"status,omitempty"`
+			IsOwner     bool     `json:"is_owner,omitempty"`
+		} `json:"filter"`
+	} `json:"query"`
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// WageSetting represents the wage setting of a team member.
+type WageSetting struct {
+	WageSetting *WageSettingEntry `json:"wage_setting"`
+}
+
+// WageSettingEntry represents the jobs and pay rates of a team member.
+type WageSettingEntry struct {
+	TeamMemberId     string          `json:"team_member_id,omitempty"`
+	JobAssignments   []JobAssignment `json:"job_assignments,omitempty"`
+	IsOvertimeExempt bool            `json:"is_overtime_exempt,omitempty"`
+	Version          int             `json:"version,omitempty"`
+	CreatedAt        *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time      `json:"updated_at,omitempty"`
+}
+
+// JobAssignment represents a job assigned to a team member, with its pay rate.
+type JobAssignment struct {
+	JobId       string       `json:"job_id,omitempty"`
+	JobTitle    string       `json:"job_title,omitempty"`
+	PayType     string       `json:"pay_type"`
+	HourlyRate  *AmountMoney `json:"hourly_rate,omitempty"`
+	AnnualRate  *AmountMoney `json:"annual_rate,omitempty"`
+	WeeklyHours int          `json:"weekly_hours,omitempty"`
+}
+
+// ListJobs represents a list of jobs.
+type ListJobs struct {
+	Jobs   []JobEntry `json:"jobs"`
+	Cursor string     `json:"cursor,omitempty"`
+}
+
+// Job represents a job.
+type Job struct {
+	Job *JobEntry `json:"job"`
+}
+
+// JobEntry represents a job definition of a seller.
+type JobEntry struct {
+	Id            string     `json:"id,omitempty"`
+	Title         string     `json:"title,omitempty"`
+	IsTipEligible bool       `json:"is_tip_eligible,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	Version       int        `json:"version,omitempty"`
+}
+
+// CreateJob represents a job to be created.
+type CreateJob struct {
+	Job            *JobEntry `json:"job"`
+	IdempotencyKey string    `json:"idempotency_key"`
+}
+
+// UpdateJob represents the changes to a job.
+type UpdateJob struct {
+	Job *JobEntry `json:"job"`
+}
+
+// CreateTeamMember creates a team member.
+func (s *TeamServiceOp) CreateTeamMember(ctx context.Context, member *CreateTeamMember) (*TeamMember, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, TeamMemberBasePath, member)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(TeamMember)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// BulkCreateTeamMembers creates several team members at once. Each team member succeeds or fails independently.
+func (s *TeamServiceOp) BulkCreateTeamMembers(ctx context.Context, members *BulkCreateTeamMembers) (*BulkTeamMembers, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(TeamMemberBasePath, "bulk-create"), members)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BulkTeamMembers)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateTeamMember updates a team member.
+func (s *TeamServiceOp) UpdateTeamMember(ctx context.Context, teamMemberId string, member *TeamMemberEntry) (*TeamMember, *Response, error) {
+	if len(teamMemberId) == 0 {
+		return nil, nil, NewArgError("teamMemberId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeTeamMember, teamMemberId)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(TeamMemberBasePath, teamMemberId), &UpdateTeamMember{TeamMember: member})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(TeamMember)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveTeamMember returns a team member by ID.
+func (s *TeamServiceOp) RetrieveTeamMember(ctx context.Context, teamMemberId string) (*TeamMember, *Response, error) {
+	if len(teamMemberId) == 0 {
+		return nil, nil, NewArgError("teamMemberId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeTeamMember, teamMemberId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(TeamMemberBasePath, teamMemberId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(TeamMember)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SearchTeamMembers searches team members by location, status and owner flag.
+func (s *TeamServiceOp) SearchTeamMembers(ctx context.Context, search *SearchTeamMembers) (*ListTeamMembers, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(TeamMemberBasePath, "search"), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListTeamMembers)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveWageSetting returns the wage setting of a team member.
+func (s *TeamServiceOp) RetrieveWageSetting(ctx context.Context, teamMemberId string) (*WageSetting, *Response, error) {
+	if len(teamMemberId) == 0 {
+		return nil, nil, NewArgError("teamMemberId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(TeamMemberBasePath, teamMemberId, teamMemberWageSettingPath), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WageSetting)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateWageSetting creates or updates the wage setting of a team member.
+func (s *TeamServiceOp) UpdateWageSetting(ctx context.Context, teamMemberId string, setting *WageSettingEntry) (*WageSetting, *Response, error) {
+	if len(teamMemberId) == 0 {
+		return nil, nil, NewArgError("teamMemberId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(TeamMemberBasePath, teamMemberId, teamMemberWageSettingPath), &WageSetting{WageSetting: setting})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WageSetting)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListJobs returns a list of the job definitions of the seller.
+func (s *TeamServiceOp) ListJobs(ctx context.Context, options *ListOptions) (*ListJobs, *Response, error) {
+	p, err := addOptions(path.Join(TeamMemberBasePath, teamMemberJobsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListJobs)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateJob creates a job definition.
+func (s *TeamServiceOp) CreateJob(ctx context.Context, job *CreateJob) (*Job, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(TeamMemberBasePath, teamMemberJobsPath), job)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Job)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveJob returns a job definition by ID.
+func (s *TeamServiceOp) RetrieveJob(ctx context.Context, jobId string) (*Job, *Response, error) {
+	if len(jobId) == 0 {
+		return nil, nil, NewArgError("jobId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(TeamMemberBasePath, teamMemberJobsPath, jobId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Job)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateJob updates the title or tip eligibility of a job definition.
+func (s *TeamServiceOp) UpdateJob(ctx context.Context, jobId string, job *JobEntry) (*Job, *Response, error) {
+	if len(jobId) == 0 {
+		return nil, nil, NewArgError("jobId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(TeamMemberBasePath, teamMemberJobsPath, jobId), &UpdateJob{Job: job})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Job)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,345 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var (
+	teamMemberResponse = `
+{
+  "team_member": {
+    "id": "1yJlHapkseYnNPETIU1B",
+    "reference_id": "reference_id_1",
+    "is_owner": false,
+    "status": "ACTIVE",
+    "given_name": "Joe",
+    "family_name": "Doe",
+    "email_address": "joe_doe@gmail.com",
+    "phone_number": "+14159283333",
+    "assigned_locations": {
+      "assignment_type": "EXPLICIT_LOCATIONS",
+      "location_ids": ["GA2Y9HSJ8KRYT", "YSGH2WBKG94QZ"]
+    }
+  }
+}`
+
+	jobResponse = `
+{
+  "job": {
+    "id": "1yJlHapkseYnNPETIU1B",
+    "title": "Cashier",
+    "is_tip_eligible": true,
+    "version": 1
+  }
+}`
+)
+
+func expectedTeamMember() *TeamMember {
+	return &TeamMember{TeamMember: &TeamMemberEntry{
+		Id:           "1yJlHapkseYnNPETIU1B",
+		ReferenceId:  "reference_id_1",
+		Status:       TeamMemberStatusActive,
+		GivenName:    "Joe",
+		FamilyName:   "Doe",
+		EmailAddress: "joe_doe@gmail.com",
+		PhoneNumber:  "+14159283333",
+		AssignedLocations: &TeamMemberAssignedLocations{
+			AssignmentType: "EXPLICIT_LOCATIONS",
+			LocationIds:    []string{"GA2Y9HSJ8KRYT", "YSGH2WBKG94QZ"},
+		},
+	}}
+}
+
+func expectedJob() *Job {
+	return &Job{Job: &JobEntry{Id: "1yJlHapkseYnNPETIU1B", Title: "Cashier", IsTipEligible: true, Version: 1}}
+}
+
+func TestTeamServiceOp_CreateTeamMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	create := &CreateTeamMember{
+		IdempotencyKey: "idempotency-key-0",
+		TeamMember: &TeamMemberEntry{
+			ReferenceId:  "reference_id_1",
+			Status:       TeamMemberStatusActive,
+			GivenName:    "Joe",
+			FamilyName:   "Doe",
+			EmailAddress: "joe_doe@gmail.com",
+		},
+	}
+
+	mux.HandleFunc("/v2/team-members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(CreateTeamMember), create)
+		fmt.Fprint(w, teamMemberResponse)
+	})
+
+	got, _, err := client.Team.CreateTeamMember(ctx, create)
+	if err != nil {
+		t.Fatalf("Team.CreateTeamMember returned error: %v", err)
+	}
+	if expected := expectedTeamMember(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.CreateTeamMember returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestTeamServiceOp_BulkCreateTeamMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	bulk := &BulkCreateTeamMembers{TeamMembers: map[string]TeamMember{
+		"idempotency-key-1": {TeamMember: &TeamMemberEntry{GivenName: "Joe", FamilyName: "Doe"}},
+		"idempotency-key-2": {TeamMember: &TeamMemberEntry{GivenName: "Jane"}},
+	}}
+
+	mux.HandleFunc("/v2/team-members/bulk-create", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(BulkCreateTeamMembers), bulk)
+		fmt.Fprint(w, `{
+  "team_members": {
+    "idempotency-key-1": {"team_member": {"id": "ywhG1qfIOoqsHfVRubFV", "given_name": "Joe", "family_name": "Doe"}},
+    "idempotency-key-2": {"errors": [{"category": "INVALID_REQUEST_ERROR", "code": "MISSING_REQUIRED_PARAMETER", "field": "family_name"}]}
+  }
+}`)
+	})
+
+	got, _, err := client.Team.BulkCreateTeamMembers(ctx, bulk)
+	if err != nil {
+		t.Fatalf("Team.BulkCreateTeamMembers returned error: %v", err)
+	}
+
+	expected := &BulkTeamMembers{TeamMembers: map[string]BulkTeamMemberResult{
+		"idempotency-key-1": {TeamMember: &TeamMemberEntry{Id: "ywhG1qfIOoqsHfVRubFV", GivenName: "Joe", FamilyName: "Doe"}},
+		"idempotency-key-2": {Errors: []APIError{{
+			Category: "INVALID_REQUEST_ERROR",
+			Code:     "MISSING_REQUIRED_PARAMETER",
+			Field:    "family_name",
+		}}},
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.BulkCreateTeamMembers returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestTeamServiceOp_UpdateTeamMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	member := &TeamMemberEntry{GivenName: "Joe", FamilyName: "Doe", EmailAddress: "joe_doe@gmail.com"}
+
+	mux.HandleFunc("/v2/team-members/1yJlHapkseYnNPETIU1B", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testJSONBody(t, r, new(UpdateTeamMember), &UpdateTeamMember{TeamMember: member})
+		fmt.Fprint(w, teamMemberResponse)
+	})
+
+	got, _, err := client.Team.UpdateTeamMember(ctx, "1yJlHapkseYnNPETIU1B", member)
+	if err != nil {
+		t.Fatalf("Team.UpdateTeamMember returned error: %v", err)
+	}
+	if expected := expectedTeamMember(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.UpdateTeamMember returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Team.UpdateTeamMember(ctx, "", member); err == nil {
+		t.Error("Team.UpdateTeamMember with an empty ID returned no error")
+	}
+}
+
+func TestTeamServiceOp_RetrieveTeamMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/team-members/1yJlHapkseYnNPETIU1B", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, teamMemberResponse)
+	})
+
+	got, _, err := client.Team.RetrieveTeamMember(ctx, "1yJlHapkseYnNPETIU1B")
+	if err != nil {
+		t.Fatalf("Team.RetrieveTeamMember returned error: %v", err)
+	}
+	if expected := expectedTeamMember(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.RetrieveTeamMember returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Team.RetrieveTeamMember(ctx, ""); err == nil {
+		t.Error("Team.RetrieveTeamMember with an empty ID returned no error")
+	}
+}
+
+func TestTeamServiceOp_SearchTeamMembers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	search := &SearchTeamMembers{Limit: 10}
+	search.Query.Filter.LocationIds = []string{"0G5P3VGACMMQZ"}
+	search.Query.Filter.Status = TeamMemberStatusActive
+
+	mux.HandleFunc("/v2/team-members/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(SearchTeamMembers), search)
+		fmt.Fprint(w, `{"team_members":[{"id":"-3oZQKPKVk6gUXU_V5Qa","status":"ACTIVE"}],"cursor":"N:9UglUjOXQ13-hMFypCft"}`)
+	})
+
+	got, _, err := client.Team.SearchTeamMembers(ctx, search)
+	if err != nil {
+		t.Fatalf("Team.SearchTeamMembers returned error: %v", err)
+	}
+
+	expected := &ListTeamMembers{
+		TeamMembers: []TeamMemberEntry{{Id: "-3oZQKPKVk6gUXU_V5Qa", Status: TeamMemberStatusActive}},
+		Cursor:      "N:9UglUjOXQ13-hMFypCft",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.SearchTeamMembers returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestTeamServiceOp_WageSetting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	setting := &WageSettingEntry{
+		JobAssignments: []JobAssignment{{
+			JobId:      "FjS8x95cqHiMenw4f1NAUH4P",
+			PayType:    "HOURLY",
+			HourlyRate: &AmountMoney{Amount: 2400, Currency: "USD"},
+		}},
+		IsOvertimeExempt: true,
+	}
+
+	mux.HandleFunc("/v2/team-members/1yJlHapkseYnNPETIU1B/wage-setting", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			testJSONBody(t, r, new(WageSetting), &WageSetting{WageSetting: setting})
+		} else {
+			testMethod(t, r, http.MethodGet)
+		}
+		fmt.Fprint(w, `{"wage_setting":{"team_member_id":"1yJlHapkseYnNPETIU1B","is_overtime_exempt":true,"version":2}}`)
+	})
+
+	expected := &WageSetting{WageSetting: &WageSettingEntry{
+		TeamMemberId:     "1yJlHapkseYnNPETIU1B",
+		IsOvertimeExempt: true,
+		Version:          2,
+	}}
+
+	got, _, err := client.Team.RetrieveWageSetting(ctx, "1yJlHapkseYnNPETIU1B")
+	if err != nil {
+		t.Fatalf("Team.RetrieveWageSetting returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.RetrieveWageSetting returned %+v, expected %+v", got, expected)
+	}
+
+	got, _, err = client.Team.UpdateWageSetting(ctx, "1yJlHapkseYnNPETIU1B", setting)
+	if err != nil {
+		t.Fatalf("Team.UpdateWageSetting returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.UpdateWageSetting returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Team.RetrieveWageSetting(ctx, ""); err == nil {
+		t.Error("Team.RetrieveWageSetting with an empty ID returned no error")
+	}
+	if _, _, err := client.Team.UpdateWageSetting(ctx, "", setting); err == nil {
+		t.Error("Team.UpdateWageSetting with an empty ID returned no error")
+	}
+}
+
+func TestTeamServiceOp_ListJobs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/team-members/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "cursor-1"})
+		fmt.Fprint(w, `{"jobs":[{"id":"1yJlHapkseYnNPETIU1B","title":"Cashier","is_tip_eligible":true,"version":1}]}`)
+	})
+
+	got, _, err := client.Team.ListJobs(ctx, &ListOptions{Cursor: "cursor-1"})
+	if err != nil {
+		t.Fatalf("Team.ListJobs returned error: %v", err)
+	}
+
+	expected := &ListJobs{Jobs: []JobEntry{*expectedJob().Job}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.ListJobs returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestTeamServiceOp_CreateJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	create := &CreateJob{
+		Job:            &JobEntry{Title: "Cashier", IsTipEligible: true},
+		IdempotencyKey: "idempotency-key-0",
+	}
+
+	mux.HandleFunc("/v2/team-members/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(CreateJob), create)
+		fmt.Fprint(w, jobResponse)
+	})
+
+	got, _, err := client.Team.CreateJob(ctx, create)
+	if err != nil {
+		t.Fatalf("Team.CreateJob returned error: %v", err)
+	}
+	if expected := expectedJob(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.CreateJob returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestTeamServiceOp_RetrieveJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/team-members/jobs/1yJlHapkseYnNPETIU1B", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, jobResponse)
+	})
+
+	got, _, err := client.Team.RetrieveJob(ctx, "1yJlHapkseYnNPETIU1B")
+	if err != nil {
+		t.Fatalf("Team.RetrieveJob returned error: %v", err)
+	}
+	if expected := expectedJob(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.RetrieveJob returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Team.RetrieveJob(ctx, ""); err == nil {
+		t.Error("Team.RetrieveJob with an empty ID returned no error")
+	}
+}
+
+func TestTeamServiceOp_UpdateJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	job := &JobEntry{Title: "Cashier", IsTipEligible: true}
+
+	mux.HandleFunc("/v2/team-members/jobs/1yJlHapkseYnNPETIU1B", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testJSONBody(t, r, new(UpdateJob), &UpdateJob{Job: job})
+		fmt.Fprint(w, jobResponse)
+	})
+
+	got, _, err := client.Team.UpdateJob(ctx, "1yJlHapkseYnNPETIU1B", job)
+	if err != nil {
+		t.Fatalf("Team.UpdateJob returned error: %v", err)
+	}
+	if expected := expectedJob(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Team.UpdateJob returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Team.UpdateJob(ctx, "", job); err == nil {
+		t.Error("Team.UpdateJob with an empty ID returned no error")
+	}
+}
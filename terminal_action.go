@@ -13,13 +13,27 @@ const (
 	terminalActionSearchPath = "search"
 )
 
+// TerminalActionType is the type of a terminal action, which determines the options it takes.
+type TerminalActionType string
+
+const (
+	TerminalActionTypeQRCode         TerminalActionType = "QR_CODE"
+	TerminalActionTypePing           TerminalActionType = "PING"
+	TerminalActionTypeSaveCard       TerminalActionType = "SAVE_CARD"
+	TerminalActionTypeSignature      TerminalActionType = "SIGNATURE"
+	TerminalActionTypeConfirmation   TerminalActionType = "CONFIRMATION"
+	TerminalActionTypeReceipt        TerminalActionType = "RECEIPT"
+	TerminalActionTypeDataCollection TerminalActionType = "DATA_COLLECTION"
+	TerminalActionTypeSelect         TerminalActionType = "SELECT"
+)
+
 // TerminalActionService is an interface for interfacing with the Square Terminal Action API
 type TerminalActionService interface {
-	Create(ctx context.Context, action *CreateTerminalActionEntry) (*GetTerminalAction, *Response, error)
-	Search(ctx context.Context, options *ListOptions, query *TerminalActionQuery) ([]SearchTerminalAction, *Response, error)
-	Get(ctx context.Context, actionId string) (*GetTerminalAction, *Response, error)
-	Cancel(ctx context.Context, actionId string) (*GetTerminalAction, *Response, error)
-	Dismiss(ctx context.Context, actionId string) (*GetTerminalAction, *Response, error)
+	Create(ctx context.Context, action *CreateTerminalActionEntry, opts ...RequestOption) (*GetTerminalAction, *Response, error)
+	Search(ctx context.Context, options *ListOptions, query *TerminalActionQuery, opts ...RequestOption) ([]SearchTerminalAction, *Response, error)
+	Get(ctx context.Context, actionId string, opts ...RequestOption) (*GetTerminalAction, *Response, error)
+	Cancel(ctx context.Context, actionId string, opts ...RequestOption) (*GetTerminalAction, *Response, error)
+	Dismiss(ctx context.Context, actionId string, opts ...RequestOption) (*GetTerminalAction, *Response, error)
 }
 
 var _ TerminalActionService = &TerminalActionServiceOp{}
@@ -37,16 +51,16 @@ type GetTerminalAction struct {
 }
 
 type TerminalActionEntry struct {
-	Id              string          `json:"id"`
-	DeviceId        string          `json:"device_id"`
-	Status          string          `json:"status"`
-	CancelReason    string          `json:"cancel_reason"`
-	CreatedAt       time.Time       `json:"created_at"`
-	UpdatedAt       time.Time       `json:"updated_at"`
-	LocationId      string          `json:"location_id"`
-	Type            string          `json:"type"`
-	AppId           string          `json:"app_id"`
-	CheckoutOptions CheckoutOptions `json:"checkout_options"`
+	Id              string             `json:"id"`
+	DeviceId        string             `json:"device_id"`
+	Status          string             `json:"status"`
+	CancelReason    string             `json:"cancel_reason"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	LocationId      string             `json:"location_id"`
+	Type            TerminalActionType `json:"type"`
+	AppId           string             `json:"app_id"`
+	CheckoutOptions CheckoutOptions    `json:"checkout_options"`
 }
 
 type CreateTerminalActionEntry struct {
@@ -74,7 +88,7 @@ type TerminalActionConfirmationOptions struct {
 	AgreeButtonText    string `json:"agree_button_text"`
 	Body               string `json:"body"`
 	Title              string `json:"title"`
-	DisagreeButtonText string `json:"disagree_button_text"`
+	DisagreeButtonText string `json:"disagree_button_text,omitempty"`
 }
 
 // TerminalActionDataCollectionOptions represents the data collection options for a terminal action
@@ -92,13 +106,13 @@ type TerminalActionQrCodeOptions struct {
 
 type TerminalActionReceiptOptions struct {
 	PaymentId   string `json:"payment_id"`
-	IsDuplicate bool   `json:"is_duplicate"`
-	PrintOnly   bool   `json:"print_only"`
+	IsDuplicate bool   `json:"is_duplicate,omitempty"`
+	PrintOnly   bool   `json:"print_only,omitempty"`
 }
 
 type TerminalActionSaveCardOptions struct {
 	CustomerId  string `json:"customer_id"`
-	ReferenceId string `json:"reference_id"`
+	ReferenceId string `json:"reference_id,omitempty"`
 }
 
 type TerminalActionSignatureOptions struct {
@@ -112,23 +126,104 @@ type TerminalActionOptions struct {
 }
 
 type TerminalActionSelectOptions struct {
-	Options []TerminalActionOptions `json:"options"`
+	Title   string                  `json:"title"`
 	Body    string                  `json:"body"`
+	Options []TerminalActionOptions `json:"options"`
 }
 
+// TerminalAction represents a terminal action to be created. Only the options of its Type are set; use the
+// New*Action constructors to build one, which check the fields required by the type.
 type TerminalAction struct {
-	ConfirmationOptions     TerminalActionConfirmationOptions   `json:"confirmation_options"`
-	DataCollectionOptions   TerminalActionDataCollectionOptions `json:"data_collection_options"`
-	QrCodeOptions           TerminalActionQrCodeOptions         `json:"qr_code_options"`
-	ReceiptOptions          TerminalActionReceiptOptions        `json:"receipt_options"`
-	SaveCardOptions         TerminalActionSaveCardOptions       `json:"save_card_options"`
-	SignatureOptions        TerminalActionSignatureOptions      `json:"signature_options"`
-	SelectOptions           TerminalActionSelectOptions         `json:"select_options"`
-	AwaitNextAction         bool                                `json:"await_next_action"`
-	AwaitNextActionDuration string                              `json:"await_next_action_duration"`
-	DeadlineDuration        string                              `json:"deadline_duration"`
-	DeviceId                string                              `json:"device_id"`
-	Type                    string                              `json:"type"`
+	ConfirmationOptions     *TerminalActionConfirmationOptions   `json:"confirmation_options,omitempty"`
+	DataCollectionOptions   *TerminalActionDataCollectionOptions `json:"data_collection_options,omitempty"`
+	QrCodeOptions           *TerminalActionQrCodeOptions         `json:"qr_code_options,omitempty"`
+	ReceiptOptions          *TerminalActionReceiptOptions        `json:"receipt_options,omitempty"`
+	SaveCardOptions         *TerminalActionSaveCardOptions       `json:"save_card_options,omitempty"`
+	SignatureOptions        *TerminalActionSignatureOptions      `json:"signature_options,omitempty"`
+	SelectOptions           *TerminalActionSelectOptions         `json:"select_options,omitempty"`
+	AwaitNextAction         bool                                 `json:"await_next_action,omitempty"`
+	AwaitNextActionDuration Duration                             `json:"await_next_action_duration,omitempty"`
+	DeadlineDuration        Duration                             `json:"deadline_duration,omitempty"`
+	DeviceId                string                               `json:"device_id"`
+	Type                    TerminalActionType                   `json:"type"`
+}
+
+// NewPingAction returns an action checking that the device is online.
+func NewPingAction(deviceId string) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{DeviceId: deviceId, Type: TerminalActionTypePing})
+}
+
+// NewConfirmationAction returns an action asking the buyer to agree or disagree with a statement.
+func NewConfirmationAction(deviceId string, options *TerminalActionConfirmationOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:            deviceId,
+		Type:                TerminalActionTypeConfirmation,
+		ConfirmationOptions: options,
+	})
+}
+
+// NewDataCollectionAction returns an action asking the buyer for an email address or a phone number.
+func NewDataCollectionAction(deviceId string, options *TerminalActionDataCollectionOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:              deviceId,
+		Type:                  TerminalActionTypeDataCollection,
+		DataCollectionOptions: options,
+	})
+}
+
+// NewQRCodeAction returns an action displaying a QR code to the buyer.
+func NewQRCodeAction(deviceId string, options *TerminalActionQrCodeOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:      deviceId,
+		Type:          TerminalActionTypeQRCode,
+		QrCodeOptions: options,
+	})
+}
+
+// NewReceiptAction returns an action offering the buyer a receipt of a payment.
+func NewReceiptAction(deviceId string, options *TerminalActionReceiptOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:       deviceId,
+		Type:           TerminalActionTypeReceipt,
+		ReceiptOptions: options,
+	})
+}
+
+// NewSaveCardAction returns an action saving the card of the buyer on file for a customer.
+func NewSaveCardAction(deviceId string, options *TerminalActionSaveCardOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:        deviceId,
+		Type:            TerminalActionTypeSaveCard,
+		SaveCardOptions: options,
+	})
+}
+
+// NewSignatureAction returns an action asking the buyer for a signature.
+func NewSignatureAction(deviceId string, options *TerminalActionSignatureOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:         deviceId,
+		Type:             TerminalActionTypeSignature,
+		SignatureOptions: options,
+	})
+}
+
+// NewSelectAction returns an action asking the buyer to pick one of several options.
+func NewSelectAction(deviceId string, options *TerminalActionSelectOptions) (TerminalAction, error) {
+	return newTerminalAction(TerminalAction{
+		DeviceId:      deviceId,
+		Type:          TerminalActionTypeSelect,
+		SelectOptions: options,
+	})
+}
+
+// newTerminalAction returns a, or a *ValidationError reporting the fields its type requires and are missing.
+func newTerminalAction(a TerminalAction) (TerminalAction, error) {
+	v := new(validator)
+	a.validate(v, "action")
+	if err := v.err(); err != nil {
+		return TerminalAction{}, err
+	}
+	return a, nil
 }
 
 // TerminalActionQuery represents the query parameters for the Search method
@@ -143,7 +238,9 @@ type TerminalActionQuery struct {
 	} `json:"sort"`
 }
 
-func (t *TerminalActionServiceOp) Search(ctx context.Context, options *ListOptions, query *TerminalActionQuery) ([]SearchTerminalAction, *Response, error) {
+func (t *TerminalActionServiceOp) Search(ctx context.Context, options *ListOptions, query *TerminalActionQuery, opts ...RequestOption) ([]SearchTerminalAction, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	path := fmt.Sprintf("%s/%s", terminalActionBasePath, terminalActionSearchPath)
 	path, err := addOptions(path, options)
 	if err != nil {
@@ -164,7 +261,9 @@ func (t *TerminalActionServiceOp) Search(ctx context.Context, options *ListOptio
 	return *root, resp, err
 }
 
-func (t *TerminalActionServiceOp) Get(ctx context.Context, actionId string) (*GetTerminalAction, *Response, error) {
+func (t *TerminalActionServiceOp) Get(ctx context.Context, actionId string, opts ...RequestOption) (*GetTerminalAction, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	if len(actionId) == 0 {
 		return nil, nil, NewArgError("actionId", "cannot be an empty string")
 	}
@@ -185,7 +284,9 @@ func (t *TerminalActionServiceOp) Get(ctx context.Context, actionId string) (*Ge
 	return root, resp, err
 }
 
-func (t *TerminalActionServiceOp) Create(ctx context.Context, action *CreateTerminalActionEntry) (*GetTerminalAction, *Response, error) {
+func (t *TerminalActionServiceOp) Create(ctx context.Context, action *CreateTerminalActionEntry, opts ...RequestOption) (*GetTerminalAction, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	req, err := t.client.NewRequest(ctx, http.MethodPost, terminalActionBasePath, action)
 	if err != nil {
 		return nil, nil, err
@@ -200,7 +301,9 @@ func (t *TerminalActionServiceOp) Create(ctx context.Context, action *CreateTerm
 	return root, resp, err
 }
 
-func (t *TerminalActionServiceOp) Cancel(ctx context.Context, actionId string) (*GetTerminalAction, *Response, error) {
+func (t *TerminalActionServiceOp) Cancel(ctx context.Context, actionId string, opts ...RequestOption) (*GetTerminalAction, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	if len(actionId) == 0 {
 		return nil, nil, NewArgError("actionId", "cannot be an empty string")
 	}
@@ -221,7 +324,9 @@ func (t *TerminalActionServiceOp) Cancel(ctx context.Context, actionId string) (
 	return root, resp, err
 }
 
-func (t *TerminalActionServiceOp) Dismiss(ctx context.Context, actionId string) (*GetTerminalAction, *Response, error) {
+func (t *TerminalActionServiceOp) Dismiss(ctx context.Context, actionId string, opts ...RequestOption) (*GetTerminalAction, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	if len(actionId) == 0 {
 		return nil, nil, NewArgError("actionId", "cannot be an empty string")
 	}
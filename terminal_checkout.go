@@ -15,11 +15,12 @@ const (
 
 // TerminalCheckoutService is an interface for interfacing with the Square Terminal API
 type TerminalCheckoutService interface {
-	CreateTerminalCheckout(ctx context.Context, checkout *CreateTerminalCheckoutEntry) (*GetTerminalCheckout, *Response, error)
-	SearchTerminalCheckout(ctx context.Context, options *ListOptions, query *TerminalActionQuery) ([]SearchTerminalCheckout, *Response, error)
-	GetTerminalCheckout(ctx context.Context, checkoutId string) (*GetTerminalCheckout, *Response, error)
-	CancelTerminalCheckout(ctx context.Context, checkoutId string) (*GetTerminalCheckout, *Response, error)
-	DismissTerminalCheckout(ctx context.Context, checkoutId string) (*GetTerminalCheckout, *Response, error)
+	CreateTerminalCheckout(ctx context.Context, checkout *CreateTerminalCheckoutEntry, opts ...RequestOption) (*GetTerminalCheckout, *Response, error)
+	SearchTerminalCheckout(ctx context.Context, options *ListOptions, query *TerminalActionQuery, opts ...RequestOption) ([]SearchTerminalCheckout, *Response, error)
+	GetTerminalCheckout(ctx context.Context, terminalCheckoutId string, opts ...RequestOption) (*GetTerminalCheckout, *Response, error)
+	CancelTerminalCheckout(ctx context.Context, terminalCheckoutId string, opts ...RequestOption) (*GetTerminalCheckout, *Response, error)
+	DismissTerminalCheckout(ctx context.Context, terminalCheckoutId string, opts ...RequestOption) (*GetTerminalCheckout, *Response, error)
+	BatchGetTerminalCheckouts(ctx context.Context, checkoutIds []string, workers int, opts ...RequestOption) map[string]BatchResult[*GetTerminalCheckout]
 }
 
 var _ TerminalCheckoutService = &TerminalCheckoutServiceOp{}
@@ -70,7 +71,9 @@ type TerminalCheckout struct {
 	TeamMemberId                   string          `json:"team_member_id"`
 }
 
-func (s *TerminalCheckoutServiceOp) CreateTerminalCheckout(ctx context.Context, checkout *CreateTerminalCheckoutEntry) (*GetTerminalCheckout, *Response, error) {
+func (s *TerminalCheckoutServiceOp) CreateTerminalCheckout(ctx context.Context, checkout *CreateTerminalCheckoutEntry, opts ...RequestOption) (*GetTerminalCheckout, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, terminalCheckoutBasePath, checkout)
 	if err != nil {
 		return nil, nil, err
@@ -85,7 +88,9 @@ func (s *TerminalCheckoutServiceOp) CreateTerminalCheckout(ctx context.Context,
 	return root, resp, err
 }
 
-func (s *TerminalCheckoutServiceOp) SearchTerminalCheckout(ctx context.Context, options *ListOptions, query *TerminalActionQuery) ([]SearchTerminalCheckout, *Response, error) {
+func (s *TerminalCheckoutServiceOp) SearchTerminalCheckout(ctx context.Context, options *ListOptions, query *TerminalActionQuery, opts ...RequestOption) ([]SearchTerminalCheckout, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
 	path := fmt.Sprintf("%s/%s", terminalCheckoutBasePath, terminalCheckoutSearchPath)
 	path, err := addOptions(path, options)
 	if err != nil {
@@ -106,12 +111,14 @@ func (s *TerminalCheckoutServiceOp) SearchTerminalCheckout(ctx context.Context,
 	return *root, resp, err
 }
 
-func (s *TerminalCheckoutServiceOp) GetTerminalCheckout(ctx context.Context, checkoutId string) (*GetTerminalCheckout, *Response, error) {
-	if len(checkoutId) == 0 {
-		return nil, nil, NewArgError("actionId", "cannot be an empty string")
+func (s *TerminalCheckoutServiceOp) GetTerminalCheckout(ctx context.Context, terminalCheckoutId string, opts ...RequestOption) (*GetTerminalCheckout, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(terminalCheckoutId) == 0 {
+		return nil, nil, NewArgError("terminalCheckoutId", "cannot be an empty string")
 	}
 
-	path := fmt.Sprintf("%s/%s", terminalCheckoutBasePath, checkoutId)
+	path := fmt.Sprintf("%s/%s", terminalCheckoutBasePath, terminalCheckoutId)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -127,12 +134,14 @@ func (s *TerminalCheckoutServiceOp) GetTerminalCheckout(ctx context.Context, che
 	return root, resp, err
 }
 
-func (s *TerminalCheckoutServiceOp) CancelTerminalCheckout(ctx context.Context, checkoutId string) (*GetTerminalCheckout, *Response, error) {
-	if len(checkoutId) == 0 {
-		return nil, nil, NewArgError("actionId", "cannot be an empty string")
+func (s *TerminalCheckoutServiceOp) CancelTerminalCheckout(ctx context.Context, terminalCheckoutId string, opts ...RequestOption) (*GetTerminalCheckout, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(terminalCheckoutId) == 0 {
+		return nil, nil, NewArgError("terminalCheckoutId", "cannot be an empty string")
 	}
 
-	path := fmt.Sprintf("%s/%s/cancel", terminalCheckoutBasePath, checkoutId)
+	path := fmt.Sprintf("%s/%s/cancel", terminalCheckoutBasePath, terminalCheckoutId)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -148,12 +157,14 @@ func (s *TerminalCheckoutServiceOp) CancelTerminalCheckout(ctx context.Context,
 	return root, resp, err
 }
 
-func (s *TerminalCheckoutServiceOp) DismissTerminalCheckout(ctx context.Context, checkoutId string) (*GetTerminalCheckout, *Response, error) {
-	if len(checkoutId) == 0 {
-		return nil, nil, NewArgError("actionId", "cannot be an empty string")
+func (s *TerminalCheckoutServiceOp) DismissTerminalCheckout(ctx context.Context, terminalCheckoutId string, opts ...RequestOption) (*GetTerminalCheckout, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(terminalCheckoutId) == 0 {
+		return nil, nil, NewArgError("terminalCheckoutId", "cannot be an empty string")
 	}
 
-	path := fmt.Sprintf("%s/%s/dismiss", terminalCheckoutBasePath, checkoutId)
+	path := fmt.Sprintf("%s/%s/dismiss", terminalCheckoutBasePath, terminalCheckoutId)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -168,3 +179,11 @@ func (s *TerminalCheckoutServiceOp) DismissTerminalCheckout(ctx context.Context,
 
 	return root, resp, err
 }
+
+// BatchGetTerminalCheckouts returns the terminal checkouts with the given IDs, retrieving at most workers of them
+// at a time (DefaultBatchWorkers if workers is not positive). The results are keyed by checkout ID.
+func (s *TerminalCheckoutServiceOp) BatchGetTerminalCheckouts(ctx context.Context, checkoutIds []string, workers int, opts ...RequestOption) map[string]BatchResult[*GetTerminalCheckout] {
+	return batchGet(ctx, checkoutIds, workers, func(ctx context.Context, id string) (*GetTerminalCheckout, *Response, error) {
+		return s.GetTerminalCheckout(ctx, id, opts...)
+	})
+}
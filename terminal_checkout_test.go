@@ -0,0 +1,61 @@
+package squareup
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTerminalCheckoutServiceOp_BatchGetTerminalCheckouts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	limited := handleBatchGet(t, "/v2/terminals/checkouts/", "checkout")
+
+	results := client.Terminal.BatchGetTerminalCheckouts(ctx, []string{"found", "missing", "limited", ""}, 0)
+
+	if len(results) != 4 {
+		t.Fatalf("Terminal.BatchGetTerminalCheckouts returned %d results, expected 4", len(results))
+	}
+	for _, id := range []string{"found", "limited"} {
+		if r := results[id]; r.Err != nil || r.Value.Checkout.Id != id {
+			t.Errorf("Terminal.BatchGetTerminalCheckouts result %q = %+v", id, r)
+		}
+	}
+	testBatchNotFound(t, results["missing"].Err)
+	if r := results[""]; r.Err == nil {
+		t.Error("Terminal.BatchGetTerminalCheckouts of an empty ID returned no error")
+	}
+	if *limited != 2 {
+		t.Errorf("Rate limited checkout was requested %d times, expected 2", *limited)
+	}
+}
+
+func TestTerminalCheckoutServiceOp_emptyID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := map[string]func() error{
+		"GetTerminalCheckout": func() error {
+			_, _, err := client.Terminal.GetTerminalCheckout(ctx, "")
+			return err
+		},
+		"CancelTerminalCheckout": func() error {
+			_, _, err := client.Terminal.CancelTerminalCheckout(ctx, "")
+			return err
+		},
+		"DismissTerminalCheckout": func() error {
+			_, _, err := client.Terminal.DismissTerminalCheckout(ctx, "")
+			return err
+		},
+	}
+	for name, call := range calls {
+		var argErr *ArgError
+		if err := call(); !errors.As(err, &argErr) || argErr.Arg() != "terminalCheckoutId" {
+			t.Errorf("Terminal.%s returned %v, expected a terminalCheckoutId error", name, err)
+		}
+	}
+}
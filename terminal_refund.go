@@ -14,11 +14,12 @@ const (
 )
 
 type TerminalRefundService interface {
-	CreateTerminalRefund(ctx context.Context, refund *CreateTerminalRefundEntry) (*GetTerminalRefund, *Response, error)
-	SearchTerminalRefund(ctx context.Context, options *ListOptions, query *TerminalRefundQuery) ([]SearchTerminalRefund, *Response, error)
-	GetTerminalRefund(ctx context.Context, refundId string) (*GetTerminalRefund, *Response, error)
-	CancelTerminalRefund(ctx context.Context, refundId string) (*GetTerminalRefund, *Response, error)
-	DismissTerminalRefund(ctx context.Context, refundId string) (*GetTerminalRefund, *Response, error)
+	CreateTerminalRefund(ctx context.Context, refund *CreateTerminalRefundEntry, opts ...RequestOption) (*GetTerminalRefund, *Response, error)
+	SearchTerminalRefund(ctx context.Context, options *ListOptions, query *TerminalRefundQuery, opts ...RequestOption) ([]SearchTerminalRefund, *Response, error)
+	GetTerminalRefund(ctx context.Context, terminalRefundId string, opts ...RequestOption) (*GetTerminalRefund, *Response, error)
+	CancelTerminalRefund(ctx context.Context, terminalRefundId string, opts ...RequestOption) (*GetTerminalRefund, *Response, error)
+	DismissTerminalRefund(ctx context.Context, terminalRefundId string, opts ...RequestOption) (*GetTerminalRefund, *Response, error)
+	BatchGetTerminalRefunds(ctx context.Context, refundIds []string, workers int, opts ...RequestOption) map[string]BatchResult[*GetTerminalRefund]
 }
 
 var _ TerminalRefundService = &TerminalRefundServiceOp{}
@@ -38,7 +39,7 @@ type TerminalRefundEntry struct {
 	AmountMoney      *AmountMoney `json:"amount_money"`
 	Reason           string       `json:"reason"`
 	DeviceId         string       `json:"device_id"`
-	DeadlineDuration string       `json:"deadline_duration"`
+	DeadlineDuration Duration     `json:"deadline_duration"`
 	Status           string       `json:"status,omitempty"`
 	CancelReason     string       `json:"cancel_reason"`
 	CreatedAt        time.Time    `json:"created_at"`
@@ -58,7 +59,7 @@ type TerminalRefund struct {
 	DeviceId         string       `json:"device_id"`
 	PaymentId        string       `json:"payment_id"`
 	Reason           string       `json:"reason"`
-	DeadlineDuration string       `json:"deadline_duration,omitempty"`
+	DeadlineDuration Duration     `json:"deadline_duration,omitempty"`
 }
 type TerminalRefundQuery struct {
 	Sort struct {
@@ -72,8 +73,10 @@ type TerminalRefundQuery struct {
 	} `json:"filter"`
 }
 
-func (t TerminalRefundServiceOp) CreateTerminalRefund(ctx context.Context, refund *CreateTerminalRefundEntry) (*GetTerminalRefund, *Response, error) {
-	req, err := t.client.NewRequest(ctx, http.MethodPost, terminalActionBasePath, refund)
+func (t TerminalRefundServiceOp) CreateTerminalRefund(ctx context.Context, refund *CreateTerminalRefundEntry, opts ...RequestOption) (*GetTerminalRefund, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	req, err := t.client.NewRequest(ctx, http.MethodPost, terminalRefundBasePath, refund)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -87,7 +90,8 @@ func (t TerminalRefundServiceOp) CreateTerminalRefund(ctx context.Context, refun
 	return root, resp, err
 }
 
-func (t TerminalRefundServiceOp) SearchTerminalRefund(ctx context.Context, options *ListOptions, query *TerminalRefundQuery) ([]SearchTerminalRefund, *Response, error) {
+func (t TerminalRefundServiceOp) SearchTerminalRefund(ctx context.Context, options *ListOptions, query *TerminalRefundQuery, opts ...RequestOption) ([]SearchTerminalRefund, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
 
 	path := fmt.Sprintf("%s/%s", terminalRefundBasePath, terminalRefundSearchPath)
 	path, err := addOptions(path, options)
@@ -109,14 +113,16 @@ func (t TerminalRefundServiceOp) SearchTerminalRefund(ctx context.Context, optio
 	return *root, resp, err
 }
 
-func (t TerminalRefundServiceOp) GetTerminalRefund(ctx context.Context, refundId string) (*GetTerminalRefund, *Response, error) {
-	if len(refundId) == 0 {
-		return nil, nil, NewArgError("actionId", "cannot be an empty string")
+func (t TerminalRefundServiceOp) GetTerminalRefund(ctx context.Context, terminalRefundId string, opts ...RequestOption) (*GetTerminalRefund, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(terminalRefundId) == 0 {
+		return nil, nil, NewArgError("terminalRefundId", "cannot be an empty string")
 	}
 
-	path := fmt.Sprintf("%s/%s", terminalRefundBasePath, refundId)
+	path := fmt.Sprintf("%s/%s", terminalRefundBasePath, terminalRefundId)
 
-	req, err := t.client.NewRequest(ctx, http.MethodPost, path, nil)
+	req, err := t.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -130,12 +136,14 @@ func (t TerminalRefundServiceOp) GetTerminalRefund(ctx context.Context, refundId
 	return root, resp, err
 }
 
-func (t TerminalRefundServiceOp) CancelTerminalRefund(ctx context.Context, refundId string) (*GetTerminalRefund, *Response, error) {
-	if len(refundId) == 0 {
-		return nil, nil, NewArgError("actionId", "cannot be an empty string")
+func (t TerminalRefundServiceOp) CancelTerminalRefund(ctx context.Context, terminalRefundId string, opts ...RequestOption) (*GetTerminalRefund, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(terminalRefundId) == 0 {
+		return nil, nil, NewArgError("terminalRefundId", "cannot be an empty string")
 	}
 
-	path := fmt.Sprintf("%s/%s/cancel", terminalRefundBasePath, refundId)
+	path := fmt.Sprintf("%s/%s/cancel", terminalRefundBasePath, terminalRefundId)
 
 	req, err := t.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -151,12 +159,14 @@ func (t TerminalRefundServiceOp) CancelTerminalRefund(ctx context.Context, refun
 	return root, resp, err
 }
 
-func (t TerminalRefundServiceOp) DismissTerminalRefund(ctx context.Context, refundId string) (*GetTerminalRefund, *Response, error) {
-	if len(refundId) == 0 {
-		return nil, nil, NewArgError("actionId", "cannot be an empty string")
+func (t TerminalRefundServiceOp) DismissTerminalRefund(ctx context.Context, terminalRefundId string, opts ...RequestOption) (*GetTerminalRefund, *Response, error) {
+	ctx = WithRequestOptions(ctx, opts...)
+
+	if len(terminalRefundId) == 0 {
+		return nil, nil, NewArgError("terminalRefundId", "cannot be an empty string")
 	}
 
-	path := fmt.Sprintf("%s/%s/dismiss", terminalRefundBasePath, refundId)
+	path := fmt.Sprintf("%s/%s/dismiss", terminalRefundBasePath, terminalRefundId)
 
 	req, err := t.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -171,3 +181,11 @@ func (t TerminalRefundServiceOp) DismissTerminalRefund(ctx context.Context, refu
 
 	return root, resp, err
 }
+
+// BatchGetTerminalRefunds returns the terminal refunds with the given IDs, retrieving at most workers of them at
+// a time (DefaultBatchWorkers if workers is not positive). The results are keyed by refund ID.
+func (t TerminalRefundServiceOp) BatchGetTerminalRefunds(ctx context.Context, refundIds []string, workers int, opts ...RequestOption) map[string]BatchResult[*GetTerminalRefund] {
+	return batchGet(ctx, refundIds, workers, func(ctx context.Context, id string) (*GetTerminalRefund, *Response, error) {
+		return t.GetTerminalRefund(ctx, id, opts...)
+	})
+}
@@ -0,0 +1,186 @@
+package squareup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewClient_terminalRefund(t *testing.T) {
+	c := NewClient(nil, ModeSandbox)
+
+	s, ok := c.TerminalRefund.(*TerminalRefundServiceOp)
+	if !ok || s.client != c {
+		t.Errorf("NewClient TerminalRefund = %#v, expected a *TerminalRefundServiceOp of the client", c.TerminalRefund)
+	}
+}
+
+func TestTerminalRefundServiceOp_CreateTerminalRefund(t *testing.T) {
+	setup()
+	defer teardown()
+
+	create := &CreateTerminalRefundEntry{
+		IdempotencyKey: "402a640b-b26f-401f-b406-46f839590c04",
+		Refund: &TerminalRefund{
+			AmountMoney: &AmountMoney{Amount: 111, Currency: "CAD"},
+			DeviceId:    "f72dfb8e-4d65-4e56-aade-ec3fb8d33291",
+			PaymentId:   "5O5OvgkcNUhl7JBuINflcjKqUzXZY",
+			Reason:      "Returning items",
+		},
+	}
+
+	mux.HandleFunc("/v2/terminals/refunds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(CreateTerminalRefundEntry)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, create) {
+			t.Errorf("Request body = %+v, expected %+v", v, create)
+		}
+
+		fmt.Fprint(w, `{"refund":{"id":"009DP5HD-5O5OvgkcNUhl7JBuINflcjKqUzXZY","payment_id":"5O5OvgkcNUhl7JBuINflcjKqUzXZY","status":"PENDING"}}`)
+	})
+
+	got, _, err := client.TerminalRefund.CreateTerminalRefund(ctx, create)
+	if err != nil {
+		t.Fatalf("TerminalRefund.CreateTerminalRefund returned error: %v", err)
+	}
+
+	expected := &GetTerminalRefund{Refund: &TerminalRefundEntry{
+		Id:        "009DP5HD-5O5OvgkcNUhl7JBuINflcjKqUzXZY",
+		PaymentId: "5O5OvgkcNUhl7JBuINflcjKqUzXZY",
+		Status:    "PENDING",
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("TerminalRefund.CreateTerminalRefund returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestTerminalRefundServiceOp_GetTerminalRefund(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/terminals/refunds/009DP5HD-5O5OvgkcNUhl7JBuINflcjKqUzXZY", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"refund":{"id":"009DP5HD-5O5OvgkcNUhl7JBuINflcjKqUzXZY","status":"COMPLETED","deadline_duration":"PT5M"}}`)
+	})
+
+	got, _, err := client.TerminalRefund.GetTerminalRefund(ctx, "009DP5HD-5O5OvgkcNUhl7JBuINflcjKqUzXZY")
+	if err != nil {
+		t.Fatalf("TerminalRefund.GetTerminalRefund returned error: %v", err)
+	}
+
+	expected := &GetTerminalRefund{Refund: &TerminalRefundEntry{
+		Id:               "009DP5HD-5O5OvgkcNUhl7JBuINflcjKqUzXZY",
+		Status:           "COMPLETED",
+		DeadlineDuration: Duration(5 * time.Minute),
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("TerminalRefund.GetTerminalRefund returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.TerminalRefund.GetTerminalRefund(ctx, ""); err == nil {
+		t.Error("TerminalRefund.GetTerminalRefund with an empty ID returned no error")
+	}
+}
+
+func TestTerminalRefundServiceOp_emptyID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := map[string]func() error{
+		"GetTerminalRefund": func() error {
+			_, _, err := client.TerminalRefund.GetTerminalRefund(ctx, "")
+			return err
+		},
+		"CancelTerminalRefund": func() error {
+			_, _, err := client.TerminalRefund.CancelTerminalRefund(ctx, "")
+			return err
+		},
+		"DismissTerminalRefund": func() error {
+			_, _, err := client.TerminalRefund.DismissTerminalRefund(ctx, "")
+			return err
+		},
+	}
+	for name, call := range calls {
+		var argErr *ArgError
+		if err := call(); !errors.As(err, &argErr) || argErr.Arg() != "terminalRefundId" {
+			t.Errorf("TerminalRefund.%s returned %v, expected a terminalRefundId error", name, err)
+		}
+	}
+}
+
+// handleBatchGet serves the resources of a BatchGet test under pattern, as {"<root>":{"id":"<id>"}}. The ID
+// "missing" is not found and the ID "limited" is rate limited once.
+func handleBatchGet(t *testing.T, pattern, root string) *int {
+	var (
+		mu      sync.Mutex
+		limited int
+	)
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		id := path.Base(r.URL.Path)
+		switch id {
+		case "missing":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors":[{"category":"INVALID_REQUEST_ERROR","code":"NOT_FOUND"}]}`)
+			return
+		case "limited":
+			mu.Lock()
+			limited++
+			first := limited == 1
+			mu.Unlock()
+			if first {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"errors":[{"category":"RATE_LIMIT_ERROR","code":"RATE_LIMITED"}]}`)
+				return
+			}
+		}
+		fmt.Fprintf(w, `{%q:{"id":%q}}`, root, id)
+	})
+	return &limited
+}
+
+func testBatchNotFound(t *testing.T, err error) {
+	t.Helper()
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) || !errorResponse.HasErrorCode("NOT_FOUND") {
+		t.Errorf("Result %q error = %v, expected NOT_FOUND", "missing", err)
+	}
+}
+
+func TestTerminalRefundServiceOp_BatchGetTerminalRefunds(t *testing.T) {
+	setup()
+	defer teardown()
+
+	defer func(backoff time.Duration) { rateLimitBackoff = backoff }(rateLimitBackoff)
+	rateLimitBackoff = time.Millisecond
+
+	limited := handleBatchGet(t, "/v2/terminals/refunds/", "refund")
+
+	results := client.TerminalRefund.BatchGetTerminalRefunds(ctx, []string{"found", "missing", "limited", "found"}, 2)
+
+	if len(results) != 3 {
+		t.Fatalf("TerminalRefund.BatchGetTerminalRefunds returned %d results, expected 3", len(results))
+	}
+	for _, id := range []string{"found", "limited"} {
+		if r := results[id]; r.Err != nil || r.Value.Refund.Id != id {
+			t.Errorf("TerminalRefund.BatchGetTerminalRefunds result %q = %+v", id, r)
+		}
+	}
+	testBatchNotFound(t, results["missing"].Err)
+	if *limited != 2 {
+		t.Errorf("Rate limited refund was requested %d times, expected 2", *limited)
+	}
+}
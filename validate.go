@@ -0,0 +1,1200 @@
+package squareup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator is implemented by request models which can check themselves before being sent. NewRequest validates
+// bodies implementing it, unless validation is disabled with DisableValidation or WithoutValidation.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError reports every invalid field of a request model. Each ArgError names the field by its JSON path,
+// e.g. refund.device_id.
+type ValidationError struct {
+	Errors []*ArgError
+}
+
+var _ error = &ValidationError{}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors of the invalid fields, so errors.As can extract an *ArgError.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// DisableValidation is a client option which turns off the validation of request models by NewRequest.
+func DisableValidation() ClientOpt {
+	return func(c *Client) error {
+		c.skipValidation = true
+		return nil
+	}
+}
+
+// WithoutValidation skips the validation of the request model of a single call.
+func WithoutValidation() RequestOption {
+	return func(o *requestOptions) {
+		o.skipValidation = true
+	}
+}
+
+var (
+	currencyCodeRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// validator collects the invalid fields of a request model.
+type validator struct {
+	errs []*ArgError
+}
+
+// add records field as invalid for the given reason.
+func (v *validator) add(field, reason string) {
+	v.errs = append(v.errs, NewArgError(field, reason))
+}
+
+// required checks that value is not empty.
+func (v *validator) required(field, value string) {
+	if len(value) == 0 {
+		v.add(field, "is required")
+	}
+}
+
+// maxLength checks that value is at most n characters long.
+func (v *validator) maxLength(field, value string, n int) {
+	if utf8.RuneCountInString(value) > n {
+		v.add(field, fmt.Sprintf("must be at most %d characters long", n))
+	}
+}
+
+// money checks that m has a non negative amount and a valid currency code, and that it is set if required.
+func (v *validator) money(field string, m *AmountMoney, required bool) {
+	if m == nil {
+		if required {
+			v.add(field, "is required")
+		}
+		return
+	}
+
+	if m.Amount < 0 {
+		v.add(field+".amount", "cannot be negative")
+	}
+	if !currencyCodeRegexp.MatchString(m.Currency) {
+		v.add(field+".currency", "must be an ISO 4217 currency code")
+	}
+}
+
+// duration checks that d, if set, is between min and max.
+func (v *validator) duration(field string, d, min, max Duration) {
+	if d != 0 && (d < min || d > max) {
+		v.add(field, fmt.Sprintf("must be between %s and %s", min, max))
+	}
+}
+
+// oneOf checks that value, if set, is one of allowed.
+func (v *validator) oneOf(field, value string, allowed ...string) {
+	if len(value) == 0 {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.add(field, "must be one of "+strings.Join(allowed, ", "))
+}
+
+// exclusive checks that at most one of fields is set, as reported by set.
+func (v *validator) exclusive(fields []string, set []bool) {
+	var present []string
+	for i, s := range set {
+		if s {
+			present = append(present, fields[i])
+		}
+	}
+	if len(present) > 1 {
+		v.add(strings.Join(present, ", "), "are mutually exclusive")
+	}
+}
+
+// validate validates body if it implements Validator and validation is enabled for ctx. An idempotency key set
+// with WithIdempotencyKey is added to the body by the caller, so it is not reported as missing.
+func (c *Client) validate(ctx context.Context, body interface{}) error {
+	v, ok := body.(Validator)
+	if !ok || c.skipValidation || requestOptionsFrom(ctx).skipValidation {
+		return nil
+	}
+
+	err := v.Validate()
+	if key := requestOptionsFrom(ctx).idempotencyKey; err != nil && len(key) > 0 {
+		err = withoutRequired(err, "idempotency_key")
+	}
+	return err
+}
+
+// withoutRequired drops the error reporting field as missing from err, a *ValidationError, returning nil if no
+// other field is invalid.
+func withoutRequired(err error, field string) error {
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		return err
+	}
+
+	v := new(validator)
+	for _, e := range verr.Errors {
+		if e.Arg() != field || e.Reason() != "is required" {
+			v.errs = append(v.errs, e)
+		}
+	}
+	return v.err()
+}
+
+// err returns the collected errors as a *ValidationError, or nil if the model is valid.
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errs}
+}
+
+// Validate checks the payment before it is created.
+func (p *CreatePayment) Validate() error {
+	v := new(validator)
+	if p == nil {
+		v.add("payment", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", p.IdempotencyKey)
+	v.maxLength("idempotency_key", p.IdempotencyKey, 45)
+	v.required("source_id", p.SourceId)
+	v.money("amount_money", p.AmountMoney, true)
+	v.money("app_fee_money", p.AppFeeMoney, false)
+	v.money("tip_money", p.TipMoney, false)
+	v.maxLength("reference_id", p.ReferenceId, 40)
+	v.maxLength("note", p.Note, 500)
+	v.maxLength("statement_description_identifier", p.StatementDescriptionIdentifier, 20)
+	v.maxLength("buyer_email_address", p.BuyerEmailAddress, 255)
+	v.maxLength("customer_id", p.CustomerId, 191)
+	v.maxLength("location_id", p.LocationId, 50)
+	v.maxLength("order_id", p.OrderId, 192)
+	v.maxLength("team_member_id", p.TeamMemberId, 35)
+	v.duration("delay_duration", p.DelayDuration, MinPaymentDelayDuration, MaxPaymentDelayDuration)
+	v.oneOf("delay_action", p.DelayAction, "CANCEL", "COMPLETE")
+
+	v.exclusive([]string{"cash_details", "external_details"}, []bool{p.CashDetails != nil, p.ExternalDetails != nil})
+	switch p.SourceId {
+	case PaymentSourceTypeCash:
+		if p.CashDetails == nil {
+			v.add("cash_details", "is required for CASH payments")
+		} else {
+			v.money("cash_details.buyer_supplied_money", p.CashDetails.BuyerSuppliedMoney, true)
+			v.money("cash_details.change_back_money", p.CashDetails.ChangeBackMoney, false)
+		}
+	case "EXTERNAL":
+		if p.ExternalDetails == nil {
+			v.add("external_details", "is required for EXTERNAL payments")
+		}
+	default:
+		if p.CashDetails != nil {
+			v.add("cash_details", "is only allowed for CASH payments")
+		}
+		if p.ExternalDetails != nil {
+			v.add("external_details", "is only allowed for EXTERNAL payments")
+		}
+	}
+
+	return v.err()
+}
+
+// Validate checks the payment update before it is sent.
+func (p *UpdatePayment) Validate() error {
+	v := new(validator)
+	if p == nil {
+		v.add("payment", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", p.IdempotencyKey)
+	v.maxLength("idempotency_key", p.IdempotencyKey, 45)
+	if p.Payment == nil {
+		v.add("payment", "is required")
+		return v.err()
+	}
+
+	v.money("payment.amount_money", p.Payment.AmountMoney, false)
+	v.money("payment.app_fee_money", p.Payment.AppFeeMoney, false)
+	v.money("payment.approved_money", p.Payment.ApprovedMoney, false)
+	v.money("payment.tip_money", p.Payment.TipMoney, false)
+	v.oneOf("payment.delay_action", p.Payment.DelayAction, "CANCEL", "COMPLETE")
+
+	return v.err()
+}
+
+// Validate checks the terminal checkout before it is created.
+func (c *CreateTerminalCheckoutEntry) Validate() error {
+	v := new(validator)
+	if c == nil {
+		v.add("checkout", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", c.IdempotencyKey)
+	v.maxLength("idempotency_key", c.IdempotencyKey, 64)
+	if c.Checkout == nil {
+		v.add("checkout", "is required")
+		return v.err()
+	}
+
+	v.money("checkout.amount_money", c.Checkout.AmountMoney, true)
+	v.money("checkout.app_fee_money", c.Checkout.AppFeeMoney, false)
+	v.money("checkout.tip_money", c.Checkout.TipMoney, false)
+	if c.Checkout.DeviceOptions == nil {
+		v.add("checkout.device_options", "is required")
+	} else {
+		v.required("checkout.device_options.device_id", c.Checkout.DeviceOptions.DeviceId)
+	}
+	v.maxLength("checkout.reference_id", c.Checkout.ReferenceId, 40)
+	v.maxLength("checkout.note", c.Checkout.Note, 250)
+	v.maxLength("checkout.statement_description_identifier", c.Checkout.StatementDescriptionIdentifier, 20)
+
+	return v.err()
+}
+
+// Validate checks the terminal refund before it is created.
+func (r *CreateTerminalRefundEntry) Validate() error {
+	v := new(validator)
+	if r == nil {
+		v.add("refund", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", r.IdempotencyKey)
+	v.maxLength("idempotency_key", r.IdempotencyKey, 64)
+	if r.Refund == nil {
+		v.add("refund", "is required")
+		return v.err()
+	}
+
+	v.required("refund.payment_id", r.Refund.PaymentId)
+	v.money("refund.amount_money", r.Refund.AmountMoney, true)
+	v.required("refund.device_id", r.Refund.DeviceId)
+	v.required("refund.reason", r.Refund.Reason)
+	v.maxLength("refund.reason", r.Refund.Reason, 192)
+	v.duration("refund.deadline_duration", r.Refund.DeadlineDuration, MinTerminalDeadlineDuration,
+		MaxTerminalDeadlineDuration)
+
+	return v.err()
+}
+
+// Validate checks the terminal action before it is created.
+func (a *CreateTerminalActionEntry) Validate() error {
+	v := new(validator)
+	if a == nil {
+		v.add("action", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", a.IdempotencyKey)
+	v.maxLength("idempotency_key", a.IdempotencyKey, 64)
+	a.Action.validate(v, "action")
+
+	return v.err()
+}
+
+// validate checks that the action has the options of its type, with their required fields, and no others.
+func (a *TerminalAction) validate(v *validator, field string) {
+	v.required(field+".device_id", a.DeviceId)
+	v.duration(field+".deadline_duration", a.DeadlineDuration, MinTerminalDeadlineDuration,
+		MaxTerminalDeadlineDuration)
+	v.duration(field+".await_next_action_duration", a.AwaitNextActionDuration, MinTerminalDeadlineDuration,
+		MaxTerminalDeadlineDuration)
+
+	if len(a.Type) == 0 {
+		v.add(field+".type", "is required")
+		return
+	}
+
+	kinds := []struct {
+		typ  TerminalActionType
+		name string
+		set  bool
+	}{
+		{TerminalActionTypeConfirmation, "confirmation_options", a.ConfirmationOptions != nil},
+		{TerminalActionTypeDataCollection, "data_collection_options", a.DataCollectionOptions != nil},
+		{TerminalActionTypeQRCode, "qr_code_options", a.QrCodeOptions != nil},
+		{TerminalActionTypeReceipt, "receipt_options", a.ReceiptOptions != nil},
+		{TerminalActionTypeSaveCard, "save_card_options", a.SaveCardOptions != nil},
+		{TerminalActionTypeSignature, "signature_options", a.SignatureOptions != nil},
+		{TerminalActionTypeSelect, "select_options", a.SelectOptions != nil},
+	}
+
+	known := a.Type == TerminalActionTypePing
+	o := field
+	for _, k := range kinds {
+		switch {
+		case k.typ == a.Type:
+			known = true
+			o = field + "." + k.name
+			if !k.set {
+				v.add(o, "is required for "+string(a.Type)+" actions")
+			}
+		case k.set:
+			v.add(field+"."+k.name, "is not allowed for "+string(a.Type)+" actions")
+		}
+	}
+	if !known {
+		v.add(field+".type", "is not a known terminal action type")
+		return
+	}
+
+	switch a.Type {
+	case TerminalActionTypeConfirmation:
+		if a.ConfirmationOptions != nil {
+			v.required(o+".title", a.ConfirmationOptions.Title)
+			v.required(o+".body", a.ConfirmationOptions.Body)
+			v.required(o+".agree_button_text", a.ConfirmationOptions.AgreeButtonText)
+		}
+	case TerminalActionTypeDataCollection:
+		if a.DataCollectionOptions != nil {
+			v.required(o+".title", a.DataCollectionOptions.Title)
+			v.required(o+".body", a.DataCollectionOptions.Body)
+			v.required(o+".input_type", a.DataCollectionOptions.InputType)
+			v.oneOf(o+".input_type", a.DataCollectionOptions.InputType, "EMAIL", "PHONE_NUMBER")
+		}
+	case TerminalActionTypeQRCode:
+		if a.QrCodeOptions != nil {
+			v.required(o+".title", a.QrCodeOptions.Title)
+			v.required(o+".body", a.QrCodeOptions.Body)
+			v.required(o+".barcode_contents", a.QrCodeOptions.BarcodeContents)
+		}
+	case TerminalActionTypeReceipt:
+		if a.ReceiptOptions != nil {
+			v.required(o+".payment_id", a.ReceiptOptions.PaymentId)
+		}
+	case TerminalActionTypeSaveCard:
+		if a.SaveCardOptions != nil {
+			v.required(o+".customer_id", a.SaveCardOptions.CustomerId)
+		}
+	case TerminalActionTypeSignature:
+		if a.SignatureOptions != nil {
+			v.required(o+".title", a.SignatureOptions.Title)
+			v.required(o+".body", a.SignatureOptions.Body)
+		}
+	case TerminalActionTypeSelect:
+		if a.SelectOptions != nil {
+			v.required(o+".title", a.SelectOptions.Title)
+			v.required(o+".body", a.SelectOptions.Body)
+			if len(a.SelectOptions.Options) == 0 {
+				v.add(o+".options", "cannot be empty")
+			}
+			for i, opt := range a.SelectOptions.Options {
+				v.required(fmt.Sprintf("%s.options[%d].reference_id", o, i), opt.ReferenceId)
+				v.required(fmt.Sprintf("%s.options[%d].title", o, i), opt.Title)
+			}
+		}
+	}
+}
+
+// Validate checks the payment link before it is created.
+func (l *CreatePaymentLink) Validate() error {
+	v := new(validator)
+	if l == nil {
+		v.add("payment_link", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", l.IdempotencyKey, 192)
+	v.maxLength("description", l.Description, 4096)
+	v.maxLength("payment_note", l.PaymentNote, 500)
+
+	switch {
+	case l.QuickPay == nil && l.Order == nil:
+		v.add("quick_pay, order", "exactly one is required")
+	case l.QuickPay != nil && l.Order != nil:
+		v.exclusive([]string{"quick_pay", "order"}, []bool{true, true})
+	case l.QuickPay != nil:
+		v.required("quick_pay.name", l.QuickPay.Name)
+		v.maxLength("quick_pay.name", l.QuickPay.Name, 255)
+		v.money("quick_pay.price_money", l.QuickPay.PriceMoney, true)
+		v.required("quick_pay.location_id", l.QuickPay.LocationId)
+	default:
+		v.required("order.location_id", l.Order.LocationId)
+	}
+
+	if o := l.CheckoutOptions; o != nil {
+		v.money("checkout_options.app_fee_money", o.AppFeeMoney, false)
+		if o.ShippingFee != nil {
+			v.money("checkout_options.shipping_fee.charge", o.ShippingFee.Charge, true)
+		}
+	}
+
+	return v.err()
+}
+
+// Validate checks the payment link update before it is sent.
+func (l *UpdatePaymentLink) Validate() error {
+	v := new(validator)
+	if l == nil || l.PaymentLink == nil {
+		v.add("payment_link", "is required")
+		return v.err()
+	}
+
+	link := l.PaymentLink
+	if link.Version == 0 {
+		v.add("payment_link.version", "is required")
+	}
+	v.maxLength("payment_link.description", link.Description, 4096)
+	v.maxLength("payment_link.payment_note", link.PaymentNote, 500)
+	if o := link.CheckoutOptions; o != nil {
+		v.money("payment_link.checkout_options.app_fee_money", o.AppFeeMoney, false)
+		if o.ShippingFee != nil {
+			v.money("payment_link.checkout_options.shipping_fee.charge", o.ShippingFee.Charge, true)
+		}
+	}
+
+	return v.err()
+}
+
+// Validate checks the booking before it is created.
+func (b *CreateBooking) Validate() error {
+	v := new(validator)
+	if b == nil {
+		v.add("booking", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", b.IdempotencyKey, 255)
+	if b.Booking == nil {
+		v.add("booking", "is required")
+		return v.err()
+	}
+
+	if b.Booking.StartAt == nil {
+		v.add("booking.start_at", "is required")
+	}
+	v.required("booking.location_id", b.Booking.LocationId)
+	if len(b.Booking.AppointmentSegments) == 0 {
+		v.add("booking.appointment_segments", "is required")
+	}
+	b.Booking.validate(v, "booking")
+
+	return v.err()
+}
+
+// Validate checks the booking update before it is sent.
+func (b *UpdateBooking) Validate() error {
+	v := new(validator)
+	if b == nil {
+		v.add("booking", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", b.IdempotencyKey, 255)
+	if b.Booking == nil {
+		v.add("booking", "is required")
+		return v.err()
+	}
+	b.Booking.validate(v, "booking")
+
+	return v.err()
+}
+
+// validate checks the notes and appointment segments of the booking.
+func (b *BookingEntry) validate(v *validator, field string) {
+	v.maxLength(field+".customer_note", b.CustomerNote, 4096)
+	v.maxLength(field+".seller_note", b.SellerNote, 4096)
+	for i, s := range b.AppointmentSegments {
+		f := fmt.Sprintf("%s.appointment_segments[%d]", field, i)
+		v.required(f+".team_member_id", s.TeamMemberId)
+		v.required(f+".service_variation_id", s.ServiceVariationId)
+	}
+}
+
+// Validate checks the availability search before it is sent.
+func (s *SearchAvailability) Validate() error {
+	v := new(validator)
+	if s == nil || s.Query == nil {
+		v.add("query", "is required")
+		return v.err()
+	}
+	if s.Query.Filter == nil {
+		v.add("query.filter", "is required")
+		return v.err()
+	}
+
+	f := s.Query.Filter
+	if f.StartAtRange == nil {
+		v.add("query.filter.start_at_range", "is required")
+	} else {
+		v.required("query.filter.start_at_range.start_at", f.StartAtRange.StartAt)
+		v.required("query.filter.start_at_range.end_at", f.StartAtRange.EndAt)
+	}
+	v.exclusive([]string{"query.filter.location_id", "query.filter.booking_id"},
+		[]bool{len(f.LocationId) > 0, len(f.BookingId) > 0})
+	for i, sf := range f.SegmentFilters {
+		v.required(fmt.Sprintf("query.filter.segment_filters[%d].service_variation_id", i), sf.ServiceVariationId)
+	}
+
+	return v.err()
+}
+
+// Validate checks the loyalty account before it is created.
+func (a *CreateLoyaltyAccount) Validate() error {
+	v := new(validator)
+	if a == nil {
+		v.add("loyalty_account", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", a.IdempotencyKey)
+	v.maxLength("idempotency_key", a.IdempotencyKey, 128)
+	if a.LoyaltyAccount == nil {
+		v.add("loyalty_account", "is required")
+		return v.err()
+	}
+
+	v.required("loyalty_account.program_id", a.LoyaltyAccount.ProgramId)
+	if a.LoyaltyAccount.Mapping == nil && len(a.LoyaltyAccount.CustomerId) == 0 {
+		v.add("loyalty_account.mapping, loyalty_account.customer_id", "one is required")
+	}
+
+	return v.err()
+}
+
+// Validate checks the points before they are accumulated.
+func (a *AccumulateLoyaltyPoints) Validate() error {
+	v := new(validator)
+	if a == nil {
+		v.add("accumulate_points", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", a.IdempotencyKey)
+	v.maxLength("idempotency_key", a.IdempotencyKey, 128)
+	v.required("location_id", a.LocationId)
+	if a.AccumulatePoints == nil {
+		v.add("accumulate_points", "is required")
+		return v.err()
+	}
+
+	hasOrder, hasPoints := len(a.AccumulatePoints.OrderId) > 0, a.AccumulatePoints.Points != 0
+	if !hasOrder && !hasPoints {
+		v.add("accumulate_points.order_id, accumulate_points.points", "one is required")
+	}
+	v.exclusive([]string{"accumulate_points.order_id", "accumulate_points.points"}, []bool{hasOrder, hasPoints})
+
+	return v.err()
+}
+
+// Validate checks the points adjustment before it is sent.
+func (a *AdjustLoyaltyPoints) Validate() error {
+	v := new(validator)
+	if a == nil {
+		v.add("adjust_points", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", a.IdempotencyKey)
+	v.maxLength("idempotency_key", a.IdempotencyKey, 128)
+	if a.AdjustPoints == nil {
+		v.add("adjust_points", "is required")
+		return v.err()
+	}
+
+	if a.AdjustPoints.Points == 0 {
+		v.add("adjust_points.points", "cannot be zero")
+	}
+	v.maxLength("adjust_points.reason", a.AdjustPoints.Reason, 255)
+
+	return v.err()
+}
+
+// Validate checks the loyalty reward before it is created.
+func (r *CreateLoyaltyReward) Validate() error {
+	v := new(validator)
+	if r == nil {
+		v.add("reward", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", r.IdempotencyKey)
+	v.maxLength("idempotency_key", r.IdempotencyKey, 128)
+	if r.Reward == nil {
+		v.add("reward", "is required")
+		return v.err()
+	}
+
+	v.required("reward.loyalty_account_id", r.Reward.LoyaltyAccountId)
+	v.required("reward.reward_tier_id", r.Reward.RewardTierId)
+
+	return v.err()
+}
+
+// Validate checks the loyalty reward redemption before it is sent.
+func (r *RedeemLoyaltyReward) Validate() error {
+	v := new(validator)
+	if r == nil {
+		v.add("redemption", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", r.IdempotencyKey)
+	v.maxLength("idempotency_key", r.IdempotencyKey, 128)
+	v.required("location_id", r.LocationId)
+
+	return v.err()
+}
+
+// Validate checks the loyalty promotion before it is created.
+func (p *CreateLoyaltyPromotion) Validate() error {
+	v := new(validator)
+	if p == nil {
+		v.add("loyalty_promotion", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", p.IdempotencyKey)
+	v.maxLength("idempotency_key", p.IdempotencyKey, 128)
+	if p.LoyaltyPromotion == nil {
+		v.add("loyalty_promotion", "is required")
+		return v.err()
+	}
+
+	promotion := p.LoyaltyPromotion
+	v.required("loyalty_promotion.name", promotion.Name)
+	v.maxLength("loyalty_promotion.name", promotion.Name, 70)
+	if promotion.Incentive == nil {
+		v.add("loyalty_promotion.incentive", "is required")
+	} else {
+		v.required("loyalty_promotion.incentive.type", promotion.Incentive.Type)
+		v.oneOf("loyalty_promotion.incentive.type", promotion.Incentive.Type,
+			LoyaltyPromotionIncentivePointsMultiplier, LoyaltyPromotionIncentivePointsAddition)
+	}
+	if promotion.AvailableTime == nil {
+		v.add("loyalty_promotion.available_time", "is required")
+	}
+	v.money("loyalty_promotion.minimum_spend_amount_money", promotion.MinimumSpendAmountMoney, false)
+
+	return v.err()
+}
+
+// Validate checks the vendor before it is created.
+func (c *CreateVendor) Validate() error {
+	v := new(validator)
+	if c == nil {
+		v.add("vendor", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", c.IdempotencyKey)
+	v.maxLength("idempotency_key", c.IdempotencyKey, 128)
+	if c.Vendor == nil {
+		v.add("vendor", "is required")
+		return v.err()
+	}
+
+	v.required("vendor.name", c.Vendor.Name)
+	c.Vendor.validate(v, "vendor")
+
+	return v.err()
+}
+
+// Validate checks the vendor update before it is sent.
+func (u *UpdateVendor) Validate() error {
+	v := new(validator)
+	if u == nil {
+		v.add("vendor", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", u.IdempotencyKey, 128)
+	if u.Vendor == nil {
+		v.add("vendor", "is required")
+		return v.err()
+	}
+	u.Vendor.validate(v, "vendor")
+
+	return v.err()
+}
+
+// validate checks the lengths of the fields of the vendor and its contacts.
+func (e *VendorEntry) validate(v *validator, field string) {
+	v.maxLength(field+".name", e.Name, 100)
+	v.maxLength(field+".account_number", e.AccountNumber, 100)
+	v.maxLength(field+".note", e.Note, 4096)
+	v.oneOf(field+".status", e.Status, VendorStatusActive, VendorStatusInactive)
+	for i, c := range e.Contacts {
+		f := fmt.Sprintf("%s.contacts[%d]", field, i)
+		if len(c.Id) == 0 {
+			v.required(f+".name", c.Name)
+		}
+		v.maxLength(f+".name", c.Name, 255)
+		v.maxLength(f+".email_address", c.EmailAddress, 255)
+		v.maxLength(f+".phone_number", c.PhoneNumber, 255)
+	}
+}
+
+// Validate checks the customer group before it is created.
+func (g *CreateCustomerGroup) Validate() error {
+	v := new(validator)
+	if g == nil {
+		v.add("group", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", g.IdempotencyKey, 45)
+	if g.Group == nil {
+		v.add("group", "is required")
+		return v.err()
+	}
+	v.required("group.name", g.Group.Name)
+
+	return v.err()
+}
+
+// Validate checks the customer group update before it is sent.
+func (g *UpdateCustomerGroup) Validate() error {
+	v := new(validator)
+	if g == nil || g.Group == nil {
+		v.add("group", "is required")
+		return v.err()
+	}
+	v.required("group.name", g.Group.Name)
+
+	return v.err()
+}
+
+// Validate checks the team member before it is created.
+func (m *CreateTeamMember) Validate() error {
+	v := new(validator)
+	if m == nil {
+		v.add("team_member", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", m.IdempotencyKey, 45)
+	if m.TeamMember == nil {
+		v.add("team_member", "is required")
+		return v.err()
+	}
+
+	v.required("team_member.given_name", m.TeamMember.GivenName)
+	v.required("team_member.family_name", m.TeamMember.FamilyName)
+	m.TeamMember.validate(v, "team_member")
+
+	return v.err()
+}
+
+// Validate checks the team member update before it is sent.
+func (m *UpdateTeamMember) Validate() error {
+	v := new(validator)
+	if m == nil || m.TeamMember == nil {
+		v.add("team_member", "is required")
+		return v.err()
+	}
+	m.TeamMember.validate(v, "team_member")
+
+	return v.err()
+}
+
+// validate checks the lengths and status of the team member.
+func (e *TeamMemberEntry) validate(v *validator, field string) {
+	v.maxLength(field+".reference_id", e.ReferenceId, 65)
+	v.maxLength(field+".email_address", e.EmailAddress, 255)
+	v.oneOf(field+".status", e.Status, TeamMemberStatusActive, TeamMemberStatusInactive)
+}
+
+// Validate checks the job before it is created.
+func (j *CreateJob) Validate() error {
+	v := new(validator)
+	if j == nil {
+		v.add("job", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", j.IdempotencyKey)
+	v.maxLength("idempotency_key", j.IdempotencyKey, 45)
+	if j.Job == nil {
+		v.add("job", "is required")
+		return v.err()
+	}
+
+	v.required("job.title", j.Job.Title)
+	v.maxLength("job.title", j.Job.Title, 150)
+
+	return v.err()
+}
+
+// Validate checks the job update before it is sent.
+func (j *UpdateJob) Validate() error {
+	v := new(validator)
+	if j == nil || j.Job == nil {
+		v.add("job", "is required")
+		return v.err()
+	}
+	v.maxLength("job.title", j.Job.Title, 150)
+
+	return v.err()
+}
+
+// Validate checks the gift card before it is created.
+func (g *CreateGiftCard) Validate() error {
+	v := new(validator)
+	if g == nil {
+		v.add("gift_card", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", g.IdempotencyKey)
+	v.maxLength("idempotency_key", g.IdempotencyKey, 128)
+	v.required("location_id", g.LocationId)
+	if g.GiftCard == nil {
+		v.add("gift_card", "is required")
+		return v.err()
+	}
+
+	v.required("gift_card.type", g.GiftCard.Type)
+	v.oneOf("gift_card.type", g.GiftCard.Type, GiftCardTypePhysical, GiftCardTypeDigital)
+	v.oneOf("gift_card.gan_source", g.GiftCard.GanSource, GiftCardGANSourceSquare, GiftCardGANSourceOther)
+	if g.GiftCard.GanSource == GiftCardGANSourceOther {
+		v.required("gift_card.gan", g.GiftCard.Gan)
+	}
+	v.maxLength("gift_card.gan", g.GiftCard.Gan, 255)
+
+	return v.err()
+}
+
+// Validate checks the gift card activity before it is created.
+func (a *CreateGiftCardActivity) Validate() error {
+	v := new(validator)
+	if a == nil {
+		v.add("gift_card_activity", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", a.IdempotencyKey)
+	v.maxLength("idempotency_key", a.IdempotencyKey, 128)
+	if a.GiftCardActivity == nil {
+		v.add("gift_card_activity", "is required")
+		return v.err()
+	}
+	a.GiftCardActivity.validate(v, "gift_card_activity")
+
+	return v.err()
+}
+
+// validate checks that the activity has the details of its type, with their required fields, and no others.
+func (e *GiftCardActivityEntry) validate(v *validator, field string) {
+	v.required(field+".location_id", e.LocationId)
+	if len(e.GiftCardId) == 0 && len(e.GiftCardGan) == 0 {
+		v.add(field+".gift_card_id, "+field+".gift_card_gan", "one is required")
+	}
+
+	if len(e.Type) == 0 {
+		v.add(field+".type", "is required")
+		return
+	}
+
+	// check validates the required fields of the details of the activity type, once they are known to be set.
+	kinds := []struct {
+		typ   GiftCardActivityType
+		name  string
+		set   bool
+		check func(field string)
+	}{
+		{GiftCardActivityTypeActivate, "activate_activity_details", e.ActivateActivityDetails != nil, func(f string) {
+			d := e.ActivateActivityDetails
+			validateGiftCardFunding(v, f, d.AmountMoney, d.OrderId, d.LineItemUid)
+		}},
+		{GiftCardActivityTypeLoad, "load_activity_details", e.LoadActivityDetails != nil, func(f string) {
+			d := e.LoadActivityDetails
+			validateGiftCardFunding(v, f, d.AmountMoney, d.OrderId, d.LineItemUid)
+		}},
+		{GiftCardActivityTypeRedeem, "redeem_activity_details", e.RedeemActivityDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.RedeemActivityDetails.AmountMoney, true)
+		}},
+		{GiftCardActivityTypeClearBalance, "clear_balance_activity_details", e.ClearBalanceActivityDetails != nil, func(f string) {
+			v.required(f+".reason", e.ClearBalanceActivityDetails.Reason)
+		}},
+		{GiftCardActivityTypeDeactivate, "deactivate_activity_details", e.DeactivateActivityDetails != nil, func(f string) {
+			v.required(f+".reason", e.DeactivateActivityDetails.Reason)
+		}},
+		{GiftCardActivityTypeAdjustIncrement, "adjust_increment_activity_details", e.AdjustIncrementActivityDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.AdjustIncrementActivityDetails.AmountMoney, true)
+			v.required(f+".reason", e.AdjustIncrementActivityDetails.Reason)
+		}},
+		{GiftCardActivityTypeAdjustDecrement, "adjust_decrement_activity_details", e.AdjustDecrementActivityDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.AdjustDecrementActivityDetails.AmountMoney, true)
+			v.required(f+".reason", e.AdjustDecrementActivityDetails.Reason)
+		}},
+		{GiftCardActivityTypeRefund, "refund_activity_details", e.RefundActivityDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.RefundActivityDetails.AmountMoney, false)
+		}},
+		{GiftCardActivityTypeBlock, "block_activity_details", e.BlockActivityDetails != nil, func(f string) {
+			v.required(f+".reason", e.BlockActivityDetails.Reason)
+		}},
+		{GiftCardActivityTypeUnblock, "unblock_activity_details", e.UnblockActivityDetails != nil, func(f string) {
+			v.required(f+".reason", e.UnblockActivityDetails.Reason)
+		}},
+		{GiftCardActivityTypeImport, "import_activity_details", e.ImportActivityDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.ImportActivityDetails.AmountMoney, true)
+		}},
+		{GiftCardActivityTypeImportReversal, "import_reversal_activity_details", e.ImportReversalActivityDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.ImportReversalActivityDetails.AmountMoney, true)
+		}},
+		{GiftCardActivityTypeUnlinkedActivityRefund, "unlinked_activity_refund_activity_details", e.UnlinkedActivityRefundDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.UnlinkedActivityRefundDetails.AmountMoney, true)
+		}},
+		{GiftCardActivityTypeTransferBalanceFrom, "transfer_balance_from_activity_details", e.TransferBalanceFromDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.TransferBalanceFromDetails.AmountMoney, true)
+		}},
+		{GiftCardActivityTypeTransferBalanceTo, "transfer_balance_to_activity_details", e.TransferBalanceToDetails != nil, func(f string) {
+			v.money(f+".amount_money", e.TransferBalanceToDetails.AmountMoney, true)
+		}},
+	}
+
+	known := false
+	for _, k := range kinds {
+		switch {
+		case k.typ == e.Type:
+			known = true
+			if !k.set {
+				v.add(field+"."+k.name, "is required for "+string(e.Type)+" activities")
+			} else {
+				k.check(field + "." + k.name)
+			}
+		case k.set:
+			v.add(field+"."+k.name, "is not allowed for "+string(e.Type)+" activities")
+		}
+	}
+	if !known {
+		v.add(field+".type", "is not a known gift card activity type")
+	}
+}
+
+// validateGiftCardFunding checks the details of an ACTIVATE or LOAD activity, which is funded either by an amount
+// or by the line item of an order.
+func validateGiftCardFunding(v *validator, field string, amount *AmountMoney, orderId, lineItemUid string) {
+	if amount == nil && len(orderId) == 0 {
+		v.add(field+".amount_money, "+field+".order_id", "one is required")
+	}
+	v.exclusive([]string{field + ".amount_money", field + ".order_id"}, []bool{amount != nil, len(orderId) > 0})
+	v.money(field+".amount_money", amount, false)
+	if len(orderId) > 0 {
+		v.required(field+".line_item_uid", lineItemUid)
+	}
+}
+
+// Validate checks the break type before it is created.
+func (b *CreateBreakType) Validate() error {
+	v := new(validator)
+	if b == nil {
+		v.add("break_type", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", b.IdempotencyKey, 128)
+	if b.BreakType == nil {
+		v.add("break_type", "is required")
+		return v.err()
+	}
+
+	v.required("break_type.location_id", b.BreakType.LocationId)
+	v.required("break_type.break_name", b.BreakType.BreakName)
+	v.required("break_type.expected_duration", b.BreakType.ExpectedDuration)
+
+	return v.err()
+}
+
+// Validate checks the shift before it is created.
+func (s *CreateShift) Validate() error {
+	v := new(validator)
+	if s == nil {
+		v.add("shift", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", s.IdempotencyKey, 128)
+	if s.Shift == nil {
+		v.add("shift", "is required")
+		return v.err()
+	}
+
+	shift := s.Shift
+	v.required("shift.location_id", shift.LocationId)
+	v.required("shift.team_member_id", shift.TeamMemberId)
+	if shift.StartAt.IsZero() {
+		v.add("shift.start_at", "is required")
+	}
+	if shift.Wage != nil {
+		v.money("shift.wage.hourly_rate", shift.Wage.HourlyRate, false)
+	}
+	v.money("shift.declared_cash_tip_money", shift.DeclaredCashTipMoney, false)
+	for i, b := range shift.Breaks {
+		f := fmt.Sprintf("shift.breaks[%d]", i)
+		if b.StartAt.IsZero() {
+			v.add(f+".start_at", "is required")
+		}
+		v.required(f+".break_type_id", b.BreakTypeId)
+		v.required(f+".name", b.Name)
+		v.required(f+".expected_duration", b.ExpectedDuration)
+	}
+
+	return v.err()
+}
+
+// Validate checks the webhook subscription before it is created.
+func (s *CreateWebhookSubscription) Validate() error {
+	v := new(validator)
+	if s == nil {
+		v.add("subscription", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", s.IdempotencyKey, 45)
+	if s.Subscription == nil {
+		v.add("subscription", "is required")
+		return v.err()
+	}
+
+	v.maxLength("subscription.name", s.Subscription.Name, 64)
+	if len(s.Subscription.EventTypes) == 0 {
+		v.add("subscription.event_types", "cannot be empty")
+	}
+	v.required("subscription.notification_url", s.Subscription.NotificationUrl)
+
+	return v.err()
+}
+
+// Validate checks the custom attribute definition before it is created or updated.
+func (d *UpsertCustomAttributeDefinition) Validate() error {
+	v := new(validator)
+	if d == nil {
+		v.add("custom_attribute_definition", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", d.IdempotencyKey, 45)
+	if d.CustomAttributeDefinition == nil {
+		v.add("custom_attribute_definition", "is required")
+		return v.err()
+	}
+
+	definition := d.CustomAttributeDefinition
+	v.maxLength("custom_attribute_definition.name", definition.Name, 255)
+	v.maxLength("custom_attribute_definition.description", definition.Description, 255)
+	v.oneOf("custom_attribute_definition.visibility", definition.Visibility, CustomAttributeVisibilityHidden,
+		CustomAttributeVisibilityReadOnly, CustomAttributeVisibilityReadWriteValues)
+
+	return v.err()
+}
+
+// Validate checks the custom attribute before it is upserted.
+func (a *UpsertCustomAttribute) Validate() error {
+	v := new(validator)
+	if a == nil {
+		v.add("custom_attribute", "cannot be nil")
+		return v.err()
+	}
+
+	v.maxLength("idempotency_key", a.IdempotencyKey, 45)
+	if a.CustomAttribute == nil {
+		v.add("custom_attribute", "is required")
+		return v.err()
+	}
+	if len(a.CustomAttribute.Value) == 0 {
+		v.add("custom_attribute.value", "is required")
+	}
+
+	return v.err()
+}
+
+// Validate checks the text evidence before it is uploaded.
+func (e *CreateDisputeEvidenceText) Validate() error {
+	v := new(validator)
+	if e == nil {
+		v.add("evidence", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", e.IdempotencyKey)
+	v.maxLength("idempotency_key", e.IdempotencyKey, 45)
+	v.required("evidence_text", e.EvidenceText)
+	v.maxLength("evidence_text", e.EvidenceText, 500)
+
+	return v.err()
+}
+
+// Validate checks the metadata of the file evidence before it is uploaded.
+func (e *CreateDisputeEvidenceFile) Validate() error {
+	v := new(validator)
+	if e == nil {
+		v.add("evidence", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", e.IdempotencyKey)
+	v.maxLength("idempotency_key", e.IdempotencyKey, 45)
+
+	return v.err()
+}
+
+// Validate checks the location update before it is sent.
+func (u *UpdateLocation) Validate() error {
+	v := new(validator)
+	if u == nil || u.Location == nil {
+		v.add("location", "is required")
+		return v.err()
+	}
+
+	v.maxLength("location.name", u.Location.Name, 255)
+	v.maxLength("location.description", u.Location.Description, 1024)
+	v.oneOf("location.status", u.Location.Status, LocationStatusActive, LocationStatusInactive)
+	v.oneOf("location.type", u.Location.Type, LocationTypePhysical, LocationTypeMobile)
+
+	return v.err()
+}
+
+// Validate checks the catalog object before it is upserted.
+func (u *UpsertCatalogObject) Validate() error {
+	v := new(validator)
+	if u == nil {
+		v.add("object", "cannot be nil")
+		return v.err()
+	}
+
+	v.required("idempotency_key", u.IdempotencyKey)
+	v.maxLength("idempotency_key", u.IdempotencyKey, 128)
+	if u.Object == nil {
+		v.add("object", "is required")
+		return v.err()
+	}
+
+	v.required("object.type", u.Object.Type)
+	v.required("object.id", u.Object.Id)
+	if u.Object.ItemData != nil {
+		v.maxLength("object.item_data.name", u.Object.ItemData.Name, 512)
+	}
+	if u.Object.ItemVariationData != nil {
+		v.oneOf("object.item_variation_data.pricing_type", u.Object.ItemVariationData.PricingType, CatalogPricingTypeFixed, CatalogPricingTypeVariable)
+		v.money("object.item_variation_data.price_money", u.Object.ItemVariationData.PriceMoney, false)
+	}
+
+	return v.err()
+}
@@ -0,0 +1,697 @@
+package squareup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testValidationArgs(t *testing.T, err error, expected []string) {
+	t.Helper()
+
+	if len(expected) == 0 {
+		if err != nil {
+			t.Errorf("Validate returned error: %v", err)
+		}
+		return
+	}
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate returned %v, expected a *ValidationError", err)
+	}
+
+	args := make([]string, len(verr.Errors))
+	for i, e := range verr.Errors {
+		args[i] = e.Arg()
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("Validate invalid fields = %v, expected %v", args, expected)
+	}
+}
+
+func TestCreatePayment_Validate(t *testing.T) {
+	valid := func() *CreatePayment {
+		return &CreatePayment{
+			IdempotencyKey: "7b0f3ec5-086a-4871-8f13-3c81b3875218",
+			SourceId:       "cnon:card-nonce-ok",
+			AmountMoney:    &AmountMoney{Amount: 1000, Currency: "USD"},
+			DelayDuration:  Duration(168 * time.Hour),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(p *CreatePayment)
+		expected []string
+	}{
+		{"valid", func(p *CreatePayment) {}, nil},
+		{"missing fields", func(p *CreatePayment) {
+			p.IdempotencyKey, p.SourceId, p.AmountMoney = "", "", nil
+		}, []string{"idempotency_key", "source_id", "amount_money"}},
+		{"lengths", func(p *CreatePayment) {
+			p.ReferenceId = "0123456789012345678901234567890123456789X"
+		}, []string{"reference_id"}},
+		{"currency", func(p *CreatePayment) {
+			p.TipMoney = &AmountMoney{Amount: -1, Currency: "usd"}
+		}, []string{"tip_money.amount", "tip_money.currency"}},
+		{"duration", func(p *CreatePayment) {
+			p.DelayDuration = Duration(30 * time.Second)
+		}, []string{"delay_duration"}},
+		{"cash without details", func(p *CreatePayment) {
+			p.SourceId = PaymentSourceTypeCash
+		}, []string{"cash_details"}},
+		{"cash with external details", func(p *CreatePayment) {
+			p.SourceId = PaymentSourceTypeCash
+			p.CashDetails = &CashDetails{BuyerSuppliedMoney: &AmountMoney{Amount: 1000, Currency: "USD"}}
+			p.ExternalDetails = &ExternalDetails{}
+		}, []string{"cash_details, external_details"}},
+		{"card with cash details", func(p *CreatePayment) {
+			p.CashDetails = &CashDetails{BuyerSuppliedMoney: &AmountMoney{Amount: 1000, Currency: "USD"}}
+		}, []string{"cash_details"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.modify(p)
+			testValidationArgs(t, p.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestCreateTerminalRefundEntry_Validate(t *testing.T) {
+	refund := &CreateTerminalRefundEntry{
+		IdempotencyKey: "402a640b-b26f-401f-b406-46f839590c04",
+		Refund: &TerminalRefund{
+			AmountMoney:      &AmountMoney{Amount: 111, Currency: "CAD"},
+			PaymentId:        "5O5OvgkcNUhl7JBuINflcjKqUzXZY",
+			Reason:           "Returning items",
+			DeadlineDuration: Duration(time.Hour),
+		},
+	}
+
+	testValidationArgs(t, refund.Validate(), []string{"refund.device_id", "refund.deadline_duration"})
+}
+
+func TestCreateTerminalActionEntry_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   TerminalAction
+		expected []string
+	}{
+		{"ping", TerminalAction{DeviceId: "{{DEVICE_ID}}", Type: TerminalActionTypePing}, nil},
+		{"receipt", TerminalAction{
+			DeviceId:       "{{DEVICE_ID}}",
+			Type:           TerminalActionTypeReceipt,
+			ReceiptOptions: &TerminalActionReceiptOptions{PaymentId: "ePZHNfBXlvxd3"},
+		}, nil},
+		{"missing options", TerminalAction{DeviceId: "{{DEVICE_ID}}", Type: TerminalActionTypeQRCode}, []string{"action.qr_code_options"}},
+		{"unknown type", TerminalAction{DeviceId: "{{DEVICE_ID}}", Type: "DANCE"}, []string{"action.type"}},
+		{"confirmation", TerminalAction{
+			DeviceId:            "{{DEVICE_ID}}",
+			Type:                TerminalActionTypeConfirmation,
+			ConfirmationOptions: &TerminalActionConfirmationOptions{Title: "Marketing", Body: "Sign up?"},
+			SignatureOptions:    &TerminalActionSignatureOptions{Title: "Sign"},
+			DeadlineDuration:    Duration(5 * time.Minute),
+		}, []string{
+			"action.signature_options",
+			"action.confirmation_options.agree_button_text",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := &CreateTerminalActionEntry{IdempotencyKey: "thahn-70e75c10-47f7-4ab6", Action: tt.action}
+			testValidationArgs(t, action.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestNewTerminalAction(t *testing.T) {
+	const deviceId = "{{DEVICE_ID}}"
+
+	tests := []struct {
+		name     string
+		new      func() (TerminalAction, error)
+		typ      TerminalActionType
+		expected []string
+	}{
+		{"ping", func() (TerminalAction, error) { return NewPingAction(deviceId) }, TerminalActionTypePing, nil},
+		{"ping without device", func() (TerminalAction, error) { return NewPingAction("") }, "", []string{"action.device_id"}},
+		{"confirmation", func() (TerminalAction, error) {
+			return NewConfirmationAction(deviceId, &TerminalActionConfirmationOptions{
+				Title: "Marketing", Body: "Sign up?", AgreeButtonText: "Yes",
+			})
+		}, TerminalActionTypeConfirmation, nil},
+		{"confirmation without fields", func() (TerminalAction, error) {
+			return NewConfirmationAction(deviceId, &TerminalActionConfirmationOptions{})
+		}, "", []string{
+			"action.confirmation_options.title",
+			"action.confirmation_options.body",
+			"action.confirmation_options.agree_button_text",
+		}},
+		{"data collection", func() (TerminalAction, error) {
+			return NewDataCollectionAction(deviceId, &TerminalActionDataCollectionOptions{
+				Title: "Contact", Body: "Your email?", InputType: "EMAIL",
+			})
+		}, TerminalActionTypeDataCollection, nil},
+		{"data collection without fields", func() (TerminalAction, error) {
+			return NewDataCollectionAction(deviceId, &TerminalActionDataCollectionOptions{InputType: "ADDRESS"})
+		}, "", []string{
+			"action.data_collection_options.title",
+			"action.data_collection_options.body",
+			"action.data_collection_options.input_type",
+		}},
+		{"qr code", func() (TerminalAction, error) {
+			return NewQRCodeAction(deviceId, &TerminalActionQrCodeOptions{
+				Title: "Menu", Body: "Scan to see the menu", BarcodeContents: "https://example.com/menu",
+			})
+		}, TerminalActionTypeQRCode, nil},
+		{"qr code without options", func() (TerminalAction, error) {
+			return NewQRCodeAction(deviceId, nil)
+		}, "", []string{"action.qr_code_options"}},
+		{"qr code without fields", func() (TerminalAction, error) {
+			return NewQRCodeAction(deviceId, &TerminalActionQrCodeOptions{})
+		}, "", []string{
+			"action.qr_code_options.title",
+			"action.qr_code_options.body",
+			"action.qr_code_options.barcode_contents",
+		}},
+		{"receipt", func() (TerminalAction, error) {
+			return NewReceiptAction(deviceId, &TerminalActionReceiptOptions{PaymentId: "ePZHNfBXlvxd3"})
+		}, TerminalActionTypeReceipt, nil},
+		{"receipt without payment", func() (TerminalAction, error) {
+			return NewReceiptAction(deviceId, &TerminalActionReceiptOptions{})
+		}, "", []string{"action.receipt_options.payment_id"}},
+		{"save card", func() (TerminalAction, error) {
+			return NewSaveCardAction(deviceId, &TerminalActionSaveCardOptions{CustomerId: "RFZfrdtm3mhO1oGzf5Cx7fEMsmGZY"})
+		}, TerminalActionTypeSaveCard, nil},
+		{"save card without customer", func() (TerminalAction, error) {
+			return NewSaveCardAction(deviceId, &TerminalActionSaveCardOptions{})
+		}, "", []string{"action.save_card_options.customer_id"}},
+		{"signature", func() (TerminalAction, error) {
+			return NewSignatureAction(deviceId, &TerminalActionSignatureOptions{Title: "Sign", Body: "Please sign"})
+		}, TerminalActionTypeSignature, nil},
+		{"signature without fields", func() (TerminalAction, error) {
+			return NewSignatureAction(deviceId, &TerminalActionSignatureOptions{})
+		}, "", []string{"action.signature_options.title", "action.signature_options.body"}},
+		{"select", func() (TerminalAction, error) {
+			return NewSelectAction(deviceId, &TerminalActionSelectOptions{
+				Title:   "Delivery",
+				Body:    "How should we deliver?",
+				Options: []TerminalActionOptions{{ReferenceId: "pickup", Title: "Pickup"}},
+			})
+		}, TerminalActionTypeSelect, nil},
+		{"select without options", func() (TerminalAction, error) {
+			return NewSelectAction(deviceId, &TerminalActionSelectOptions{Title: "Delivery", Body: "How should we deliver?"})
+		}, "", []string{"action.select_options.options"}},
+		{"select option without title", func() (TerminalAction, error) {
+			return NewSelectAction(deviceId, &TerminalActionSelectOptions{
+				Title:   "Delivery",
+				Body:    "How should we deliver?",
+				Options: []TerminalActionOptions{{ReferenceId: "pickup"}},
+			})
+		}, "", []string{"action.select_options.options[0].title"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, err := tt.new()
+			testValidationArgs(t, err, tt.expected)
+			if action.Type != tt.typ {
+				t.Errorf("Action type = %q, expected %q", action.Type, tt.typ)
+			}
+		})
+	}
+}
+
+func TestCreateBooking_Validate(t *testing.T) {
+	startAt := time.Date(2022, 10, 11, 15, 0, 0, 0, time.UTC)
+	valid := func() *CreateBooking {
+		return &CreateBooking{
+			IdempotencyKey: "32f6e3e2-8e2b-4d7c-8a3d-1f2d6c4a5b6e",
+			Booking: &BookingEntry{
+				StartAt:    &startAt,
+				LocationId: "SNTR5190QMFGM",
+				AppointmentSegments: []AppointmentSegment{
+					{DurationMinutes: 60, TeamMemberId: "TMXUrsBWWcHTt79t", ServiceVariationId: "RU3PBTZTK7DXZDQFCJHOK2MC"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(b *CreateBooking)
+		expected []string
+	}{
+		{"valid", func(b *CreateBooking) {}, nil},
+		{"missing booking", func(b *CreateBooking) { b.Booking = nil }, []string{"booking"}},
+		{"missing fields", func(b *CreateBooking) {
+			b.Booking.StartAt, b.Booking.LocationId, b.Booking.AppointmentSegments = nil, "", nil
+		}, []string{"booking.start_at", "booking.location_id", "booking.appointment_segments"}},
+		{"segment", func(b *CreateBooking) {
+			b.Booking.AppointmentSegments[0].TeamMemberId = ""
+		}, []string{"booking.appointment_segments[0].team_member_id"}},
+		{"notes", func(b *CreateBooking) {
+			b.Booking.CustomerNote = strings.Repeat("x", 4097)
+		}, []string{"booking.customer_note"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := valid()
+			tt.modify(b)
+			testValidationArgs(t, b.Validate(), tt.expected)
+		})
+	}
+
+	update := &UpdateBooking{Booking: &BookingEntry{SellerNote: strings.Repeat("x", 4097)}}
+	testValidationArgs(t, update.Validate(), []string{"booking.seller_note"})
+}
+
+func TestLoyalty_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    Validator
+		expected []string
+	}{
+		{"account", &CreateLoyaltyAccount{
+			IdempotencyKey: "ec78c477-b1c3-4899-a209-a4e71337c996",
+			LoyaltyAccount: &LoyaltyAccountEntry{ProgramId: "d619f755-2d17-41f3-990d-c04ecedd64dd", CustomerId: "QPTXM8PQNX3Q726ZYHPMNP46XC"},
+		}, nil},
+		{"account without customer", &CreateLoyaltyAccount{
+			LoyaltyAccount: &LoyaltyAccountEntry{},
+		}, []string{"idempotency_key", "loyalty_account.program_id", "loyalty_account.mapping, loyalty_account.customer_id"}},
+		{"accumulate", &AccumulateLoyaltyPoints{
+			IdempotencyKey:   "58b90739-c3e8-4b11-85f7-e636d48d72cb",
+			LocationId:       "P034NEENMD09F",
+			AccumulatePoints: &LoyaltyEventDetails{OrderId: "RFZfrdtm3mhO1oGzf5Cx7fEMsmGZY"},
+		}, nil},
+		{"accumulate order and points", &AccumulateLoyaltyPoints{
+			IdempotencyKey:   "58b90739-c3e8-4b11-85f7-e636d48d72cb",
+			LocationId:       "P034NEENMD09F",
+			AccumulatePoints: &LoyaltyEventDetails{OrderId: "RFZfrdtm3mhO1oGzf5Cx7fEMsmGZY", Points: 10},
+		}, []string{"accumulate_points.order_id, accumulate_points.points"}},
+		{"accumulate nothing", &AccumulateLoyaltyPoints{AccumulatePoints: &LoyaltyEventDetails{}}, []string{
+			"idempotency_key", "location_id", "accumulate_points.order_id, accumulate_points.points",
+		}},
+		{"adjust zero", &AdjustLoyaltyPoints{
+			IdempotencyKey: "bc29a517-3dc9-450e-aa76-fae39ee849d1",
+			AdjustPoints:   &LoyaltyEventDetails{},
+		}, []string{"adjust_points.points"}},
+		{"reward", &CreateLoyaltyReward{IdempotencyKey: "18c2e5ea-a620-4b1f-ad60-7b167285e451", Reward: &LoyaltyRewardEntry{}}, []string{
+			"reward.loyalty_account_id", "reward.reward_tier_id",
+		}},
+		{"redeem", &RedeemLoyaltyReward{}, []string{"idempotency_key", "location_id"}},
+		{"promotion", &CreateLoyaltyPromotion{
+			IdempotencyKey: "12345678-bf7d-4df0-9ba6-c5c7e9e4b2ef",
+			LoyaltyPromotion: &LoyaltyPromotionEntry{
+				Incentive:               &LoyaltyPromotionIncentive{Type: "DOUBLE"},
+				MinimumSpendAmountMoney: &AmountMoney{Amount: 2000, Currency: "usd"},
+			},
+		}, []string{
+			"loyalty_promotion.name",
+			"loyalty_promotion.incentive.type",
+			"loyalty_promotion.available_time",
+			"loyalty_promotion.minimum_spend_amount_money.currency",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testValidationArgs(t, tt.model.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestVendorCustomerTeam_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		model    Validator
+		expected []string
+	}{
+		{"vendor", &CreateVendor{IdempotencyKey: "8fc6a5b0-9fe8-4b46-b46b-2ef95793abbe", Vendor: &VendorEntry{Name: "Vendor Name"}}, nil},
+		{"vendor without name", &CreateVendor{Vendor: &VendorEntry{
+			Status:   "GONE",
+			Contacts: []VendorContact{{EmailAddress: "joe@joes-fresh-fish.com"}},
+		}}, []string{"idempotency_key", "vendor.name", "vendor.status", "vendor.contacts[0].name"}},
+		{"vendor update", &UpdateVendor{Vendor: &VendorEntry{Name: strings.Repeat("x", 101)}}, []string{"vendor.name"}},
+		{"customer group", &CreateCustomerGroup{Group: &CustomerGroupEntry{}}, []string{"group.name"}},
+		{"customer group update", &UpdateCustomerGroup{}, []string{"group"}},
+		{"team member", &CreateTeamMember{TeamMember: &TeamMemberEntry{Status: "AWAY"}}, []string{
+			"team_member.given_name", "team_member.family_name", "team_member.status",
+		}},
+		{"team member update", &UpdateTeamMember{TeamMember: &TeamMemberEntry{Status: TeamMemberStatusInactive}}, nil},
+		{"job", &CreateJob{Job: &JobEntry{}}, []string{"idempotency_key", "job.title"}},
+		{"job update", &UpdateJob{Job: &JobEntry{Title: strings.Repeat("x", 151)}}, []string{"job.title"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testValidationArgs(t, tt.model.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestNewPingAction_json(t *testing.T) {
+	action, err := NewPingAction("{{DEVICE_ID}}")
+	if err != nil {
+		t.Fatalf("NewPingAction returned error: %v", err)
+	}
+
+	data, err := json.Marshal(action)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	expected := `{"device_id":"{{DEVICE_ID}}","type":"PING"}`
+	if string(data) != expected {
+		t.Errorf("json.Marshal = %s, expected %s", data, expected)
+	}
+}
+
+func TestNewRequest_validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := &CreatePayment{SourceId: "cnon:card-nonce-ok"}
+
+	_, err := client.NewRequest(ctx, http.MethodPost, "v2/payments", body)
+	var argErr *ArgError
+	if !errors.As(err, &argErr) || argErr.Arg() != "idempotency_key" {
+		t.Errorf("NewRequest() returned %v, expected an idempotency_key error", err)
+	}
+
+	keyed := WithRequestOptions(ctx, WithIdempotencyKey("4935a656-a929-4792-b97c-8848be85c27c"))
+	keyless := &CreatePayment{SourceId: "cnon:card-nonce-ok", AmountMoney: &AmountMoney{Amount: 1000, Currency: "USD"}}
+	req, err := client.NewRequest(keyed, http.MethodPost, "v2/payments", keyless)
+	if err != nil {
+		t.Fatalf("NewRequest() with an idempotency key option returned error: %v", err)
+	}
+	testRawBody(t, req, `"idempotency_key":"4935a656-a929-4792-b97c-8848be85c27c"`)
+
+	if _, err := client.NewRequest(keyed, http.MethodPost, "v2/payments", &CreatePayment{}); err == nil {
+		t.Error("NewRequest() with an idempotency key option and missing fields returned no error")
+	}
+
+	if _, err := client.NewRequest(WithRequestOptions(ctx, WithoutValidation()), http.MethodPost, "v2/payments", body); err != nil {
+		t.Errorf("NewRequest() without validation returned error: %v", err)
+	}
+
+	c, err := New(nil, ModeSandbox, DisableValidation())
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	if _, err := c.NewRequest(ctx, http.MethodPost, "v2/payments", body); err != nil {
+		t.Errorf("NewRequest() with validation disabled returned error: %v", err)
+	}
+}
+
+func TestUpdatePaymentLink_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     *UpdatePaymentLink
+		expected []string
+	}{
+		{"valid", &UpdatePaymentLink{PaymentLink: &PaymentLinkEntry{Version: 1, Description: "Updated"}}, nil},
+		{"missing link", &UpdatePaymentLink{}, []string{"payment_link"}},
+		{"invalid", &UpdatePaymentLink{PaymentLink: &PaymentLinkEntry{
+			PaymentNote:     strings.Repeat("x", 501),
+			CheckoutOptions: &PaymentLinkCheckoutOptions{AppFeeMoney: &AmountMoney{Amount: -1, Currency: "USD"}},
+		}}, []string{
+			"payment_link.version", "payment_link.payment_note", "payment_link.checkout_options.app_fee_money.amount",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testValidationArgs(t, tt.link.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestSearchAvailability_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		search   *SearchAvailability
+		expected []string
+	}{
+		{"valid", &SearchAvailability{Query: &AvailabilityQuery{Filter: &AvailabilityFilter{
+			StartAtRange: &TimeRange{StartAt: "2022-10-12T07:20:50.52Z", EndAt: "2022-10-13T07:20:50.52Z"},
+			LocationId:   "LEQHH0YY8B42M",
+		}}}, nil},
+		{"missing query", &SearchAvailability{}, []string{"query"}},
+		{"missing filter", &SearchAvailability{Query: &AvailabilityQuery{}}, []string{"query.filter"}},
+		{"invalid", &SearchAvailability{Query: &AvailabilityQuery{Filter: &AvailabilityFilter{
+			StartAtRange:   &TimeRange{StartAt: "2022-10-12T07:20:50.52Z"},
+			LocationId:     "LEQHH0YY8B42M",
+			BookingId:      "zkras0xv0xwswx",
+			SegmentFilters: []SegmentFilter{{}},
+		}}}, []string{
+			"query.filter.start_at_range.end_at",
+			"query.filter.location_id, query.filter.booking_id",
+			"query.filter.segment_filters[0].service_variation_id",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testValidationArgs(t, tt.search.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestCreateGiftCard_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		card     *CreateGiftCard
+		expected []string
+	}{
+		{"valid", &CreateGiftCard{
+			IdempotencyKey: "NC9Tm69EjbjtConu",
+			LocationId:     "81FN9BNFZTKS4",
+			GiftCard:       &GiftCardEntry{Type: GiftCardTypeDigital},
+		}, nil},
+		{"missing fields", &CreateGiftCard{}, []string{"idempotency_key", "location_id", "gift_card"}},
+		{"invalid", &CreateGiftCard{
+			IdempotencyKey: "NC9Tm69EjbjtConu",
+			LocationId:     "81FN9BNFZTKS4",
+			GiftCard:       &GiftCardEntry{Type: "PLASTIC", GanSource: GiftCardGANSourceOther},
+		}, []string{"gift_card.type", "gift_card.gan"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testValidationArgs(t, tt.card.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestCreateGiftCardActivity_Validate(t *testing.T) {
+	activity := func(e *GiftCardActivityEntry) *CreateGiftCardActivity {
+		e.LocationId, e.GiftCardId = "81FN9BNFZTKS4", "gftc:6d55a72470d940c6ba09c0ab8ad08d20"
+		return &CreateGiftCardActivity{IdempotencyKey: "U16kfr-kA70er-q4Rsym-7U7NnY", GiftCardActivity: e}
+	}
+	money := &AmountMoney{Amount: 1000, Currency: "USD"}
+
+	tests := []struct {
+		name     string
+		activity *CreateGiftCardActivity
+		expected []string
+	}{
+		{"activate", activity(&GiftCardActivityEntry{
+			Type:                    GiftCardActivityTypeActivate,
+			ActivateActivityDetails: &GiftCardActivityActivate{OrderId: "jJNGHm4gLI6XkFbwtiSLqK72KkAZY", LineItemUid: "eIWl7X0nMuO9Ewbh0ChIx"},
+		}), nil},
+		{"missing fields", &CreateGiftCardActivity{GiftCardActivity: &GiftCardActivityEntry{}}, []string{
+			"idempotency_key",
+			"gift_card_activity.location_id",
+			"gift_card_activity.gift_card_id, gift_card_activity.gift_card_gan",
+			"gift_card_activity.type",
+		}},
+		{"missing details", activity(&GiftCardActivityEntry{Type: GiftCardActivityTypeRedeem}), []string{
+			"gift_card_activity.redeem_activity_details",
+		}},
+		{"details of another type", activity(&GiftCardActivityEntry{
+			Type:                  GiftCardActivityTypeRedeem,
+			RedeemActivityDetails: &GiftCardActivityRedeem{AmountMoney: money},
+			LoadActivityDetails:   &GiftCardActivityLoad{AmountMoney: money},
+		}), []string{"gift_card_activity.load_activity_details"}},
+		{"unknown type", activity(&GiftCardActivityEntry{Type: "SPEND"}), []string{"gift_card_activity.type"}},
+		{"load with amount and order", activity(&GiftCardActivityEntry{
+			Type:                GiftCardActivityTypeLoad,
+			LoadActivityDetails: &GiftCardActivityLoad{AmountMoney: money, OrderId: "jJNGHm4gLI6XkFbwtiSLqK72KkAZY"},
+		}), []string{
+			"gift_card_activity.load_activity_details.amount_money, gift_card_activity.load_activity_details.order_id",
+			"gift_card_activity.load_activity_details.line_item_uid",
+		}},
+		{"adjust", activity(&GiftCardActivityEntry{
+			Type:                           GiftCardActivityTypeAdjustDecrement,
+			AdjustDecrementActivityDetails: &GiftCardActivityAdjust{},
+		}), []string{
+			"gift_card_activity.adjust_decrement_activity_details.amount_money",
+			"gift_card_activity.adjust_decrement_activity_details.reason",
+		}},
+		{"block", activity(&GiftCardActivityEntry{
+			Type:                 GiftCardActivityTypeBlock,
+			BlockActivityDetails: &GiftCardActivityReason{},
+		}), []string{"gift_card_activity.block_activity_details.reason"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testValidationArgs(t, tt.activity.Validate(), tt.expected)
+		})
+	}
+}
+
+func TestCreateBreakType_Validate(t *testing.T) {
+	valid := &CreateBreakType{BreakType: &BreakTypeEntry{
+		LocationId:       "CGJN03P1D08GF",
+		BreakName:        "Lunch Break",
+		ExpectedDuration: "PT30M",
+	}}
+	testValidationArgs(t, valid.Validate(), nil)
+
+	invalid := &CreateBreakType{IdempotencyKey: strings.Repeat("x", 129), BreakType: &BreakTypeEntry{}}
+	testValidationArgs(t, invalid.Validate(), []string{
+		"idempotency_key", "break_type.location_id", "break_type.break_name", "break_type.expected_duration",
+	})
+}
+
+func TestCreateShift_Validate(t *testing.T) {
+	startAt := time.Date(2019, 1, 25, 8, 11, 0, 0, time.UTC)
+	valid := &CreateShift{Shift: &ShiftEntry{
+		LocationId:   "PAA1RJZZKXBFG",
+		TeamMemberId: "ormj0jJJZ5OZIzxrZYJI",
+		StartAt:      startAt,
+		Wage:         &ShiftWage{HourlyRate: &AmountMoney{Amount: 1100, Currency: "USD"}},
+	}}
+	testValidationArgs(t, valid.Validate(), nil)
+
+	invalid := &CreateShift{Shift: &ShiftEntry{
+		Wage:   &ShiftWage{HourlyRate: &AmountMoney{Amount: 1100, Currency: "usd"}},
+		Breaks: []ShiftBreak{{StartAt: startAt, Name: "Tea Break", ExpectedDuration: "PT5M"}},
+	}}
+	testValidationArgs(t, invalid.Validate(), []string{
+		"shift.location_id",
+		"shift.team_member_id",
+		"shift.start_at",
+		"shift.wage.hourly_rate.currency",
+		"shift.breaks[0].break_type_id",
+	})
+}
+
+func TestCreateWebhookSubscription_Validate(t *testing.T) {
+	valid := &CreateWebhookSubscription{Subscription: &WebhookSubscriptionEntry{
+		EventTypes:      []EventType{"payment.created"},
+		NotificationUrl: "https://example-webhook-url.com",
+	}}
+	testValidationArgs(t, valid.Validate(), nil)
+
+	invalid := &CreateWebhookSubscription{Subscription: &WebhookSubscriptionEntry{Name: strings.Repeat("x", 65)}}
+	testValidationArgs(t, invalid.Validate(), []string{
+		"subscription.name", "subscription.event_types", "subscription.notification_url",
+	})
+}
+
+func TestUpsertCustomAttributeDefinition_Validate(t *testing.T) {
+	valid := &UpsertCustomAttributeDefinition{CustomAttributeDefinition: &CustomAttributeDefinitionEntry{
+		Key:        "favoritemovie",
+		Schema:     NewCustomAttributeSchema(CustomAttributeSchemaString),
+		Name:       "Favorite Movie",
+		Visibility: CustomAttributeVisibilityReadOnly,
+	}}
+	testValidationArgs(t, valid.Validate(), nil)
+
+	invalid := &UpsertCustomAttributeDefinition{CustomAttributeDefinition: &CustomAttributeDefinitionEntry{
+		Description: strings.Repeat("x", 256),
+		Visibility:  "PUBLIC",
+	}}
+	testValidationArgs(t, invalid.Validate(), []string{
+		"custom_attribute_definition.description", "custom_attribute_definition.visibility",
+	})
+	testValidationArgs(t, (&UpsertCustomAttributeDefinition{}).Validate(), []string{"custom_attribute_definition"})
+}
+
+func TestUpsertCustomAttribute_Validate(t *testing.T) {
+	attribute, err := NewCustomAttribute("favoritemovie", "Dune")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testValidationArgs(t, (&UpsertCustomAttribute{CustomAttribute: attribute}).Validate(), nil)
+
+	invalid := &UpsertCustomAttribute{IdempotencyKey: strings.Repeat("x", 46), CustomAttribute: &CustomAttributeEntry{}}
+	testValidationArgs(t, invalid.Validate(), []string{"idempotency_key", "custom_attribute.value"})
+}
+
+func TestCreateDisputeEvidenceText_Validate(t *testing.T) {
+	valid := &CreateDisputeEvidenceText{IdempotencyKey: "ed3ee3933d946f1514d505d173c82648", EvidenceText: "1Z8888888888888888"}
+	testValidationArgs(t, valid.Validate(), nil)
+
+	invalid := &CreateDisputeEvidenceText{EvidenceText: strings.Repeat("x", 501)}
+	testValidationArgs(t, invalid.Validate(), []string{"idempotency_key", "evidence_text"})
+}
+
+func TestCreateDisputeEvidenceFile_Validate(t *testing.T) {
+	testValidationArgs(t, (&CreateDisputeEvidenceFile{IdempotencyKey: "a0b9a6c1-9e1a-4a7b-b4b7-3e2cfc5d6f55"}).Validate(), nil)
+	testValidationArgs(t, (&CreateDisputeEvidenceFile{}).Validate(), []string{"idempotency_key"})
+}
+
+func TestUpdateLocation_Validate(t *testing.T) {
+	testValidationArgs(t, (&UpdateLocation{Location: &LocationEntry{Name: "Midtown", Status: LocationStatusActive}}).Validate(), nil)
+
+	invalid := &UpdateLocation{Location: &LocationEntry{Name: strings.Repeat("x", 256), Type: "VIRTUAL"}}
+	testValidationArgs(t, invalid.Validate(), []string{"location.name", "location.type"})
+	testValidationArgs(t, (&UpdateLocation{}).Validate(), []string{"location"})
+}
+
+func TestUpsertCatalogObject_Validate(t *testing.T) {
+	valid := &UpsertCatalogObject{
+		IdempotencyKey: "af3d1afc-7212-4300-b463-0bfc5314a5ae",
+		Object: &CatalogObjectEntry{
+			Type: CatalogObjectTypeItemVariation,
+			Id:   "#Small",
+			ItemVariationData: &CatalogItemVariation{
+				PricingType: CatalogPricingTypeFixed,
+				PriceMoney:  &AmountMoney{Amount: 300, Currency: "USD"},
+			},
+		},
+	}
+	testValidationArgs(t, valid.Validate(), nil)
+
+	invalid := &UpsertCatalogObject{Object: &CatalogObjectEntry{
+		ItemVariationData: &CatalogItemVariation{PricingType: "FREE", PriceMoney: &AmountMoney{Amount: -1, Currency: "USD"}},
+	}}
+	testValidationArgs(t, invalid.Validate(), []string{
+		"idempotency_key", "object.type", "object.id",
+		"object.item_variation_data.pricing_type", "object.item_variation_data.price_money.amount",
+	})
+}
+
+func TestNewUploadRequest_validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	evidence := &CreateDisputeEvidenceFile{EvidenceType: "GENERIC_EVIDENCE"}
+	upload := func(ctx context.Context) error {
+		_, err := client.NewUploadRequest(ctx, "v2/disputes/bVTprrwk0gygTLZ96VX1oB/evidence-files", evidence,
+			"image_file", "customer-interaction.jpg", "image/jpeg", strings.NewReader("jpeg-bytes"))
+		return err
+	}
+
+	testValidationArgs(t, upload(ctx), []string{"idempotency_key"})
+	if err := upload(WithRequestOptions(ctx, WithIdempotencyKey("a0b9a6c1-9e1a-4a7b-b4b7-3e2cfc5d6f55"))); err != nil {
+		t.Errorf("NewUploadRequest() with an idempotency key option returned error: %v", err)
+	}
+	if err := upload(WithRequestOptions(ctx, WithoutValidation())); err != nil {
+		t.Errorf("NewUploadRequest() without validation returned error: %v", err)
+	}
+}
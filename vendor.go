@@ -0,0 +1,270 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	VendorBasePath = "v2/vendors"
+
+	vendorBulkCreatePath   = "bulk-create"
+	vendorBulkRetrievePath = "bulk-retrieve"
+	vendorBulkUpdatePath   = "bulk-update"
+	vendorCreatePath       = "create"
+	vendorSearchPath       = "search"
+)
+
+// Vendor statuses.
+const (
+	VendorStatusActive   = "ACTIVE"
+	VendorStatusInactive = "INACTIVE"
+)
+
+// VendorService is an interface for interfacing with the Square Vendors API.
+type VendorService interface {
+	BulkCreateVendors(ctx context.Context, vendors map[string]VendorEntry) (*BulkVendors, *Response, error)
+	BulkRetrieveVendors(ctx context.Context, vendorIds []string) (*BulkVendors, *Response, error)
+	BulkUpdateVendors(ctx context.Context, vendors map[string]UpdateVendor) (*BulkVendors, *Response, error)
+	CreateVendor(ctx context.Context, vendor *CreateVendor) (*Vendor, *Response, error)
+	RetrieveVendor(ctx context.Context, vendorId string) (*Vendor, *Response, error)
+	UpdateVendor(ctx context.Context, vendorId string, vendor *UpdateVendor) (*Vendor, *Response, error)
+	SearchVendors(ctx context.Context, search *SearchVendors) (*ListVendors, *Response, error)
+}
+
+var _ VendorService = &VendorServiceOp{}
+
+// VendorServiceOp handles communication with the vendor related methods of the Square API.
+type VendorServiceOp struct {
+	client *Client
+}
+
+// ListVendors represents a list of vendors.
+type ListVendors struct {
+	Vendors []VendorEntry `json:"vendors"`
+	Cursor  string        `json:"cursor,omitempty"`
+}
+
+// Vendor represents a vendor.
+type Vendor struct {
+	Vendor *VendorEntry `json:"vendor"`
+}
+
+// VendorEntry represents a supplier of a seller.
+type VendorEntry struct {
+	Id            string          `json:"id,omitempty"`
+	CreatedAt     *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time      `json:"updated_at,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Address       *BillingAddress `json:"address,omitempty"`
+	Contacts      []VendorContact `json:"contacts,omitempty"`
+	AccountNumber string          `json:"account_number,omitempty"`
+	Note          string          `json:"note,omitempty"`
+	Version       int             `json:"version,omitempty"`
+	Status        string          `json:"status,omitempty"`
+}
+
+// VendorContact represents a contact person of a vendor. Contacts are ordered by Ordinal, and removed by
+// setting Removed on update.
+type VendorContact struct {
+	Id           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	EmailAddress string `json:"email_address,omitempty"`
+	PhoneNumber  string `json:"phone_number,omitempty"`
+	Removed      bool   `json:"removed,omitempty"`
+	Ordinal      int    `json:"ordinal"`
+}
+
+// CreateVendor represents a vendor to be created.
+type CreateVendor struct {
+	IdempotencyKey string       `json:"idempotency_key"`
+	Vendor         *VendorEntry `json:"vendor"`
+}
+
+// UpdateVendor represents an update of a vendor. Vendor.Version guards against overwriting concurrent changes.
+type UpdateVendor struct {
+	IdempotencyKey string       `json:"idempotency_key,omitempty"`
+	Vendor         *VendorEntry `json:"vendor"`
+}
+
+// BulkVendors represents the result of a bulk vendor operation, keyed like the request.
+type BulkVendors struct {
+	Responses map[string]BulkVendorResult `json:"responses"`
+	Errors    []APIError                  `json:"errors,omitempty"`
+}
+
+// BulkVendorResult represents the result of one vendor of a bulk operation.
+type BulkVendorResult struct {
+	Vendor *VendorEntry `json:"vendor,omitempty"`
+	Errors []APIError   `json:"errors,omitempty"`
+}
+
+// SearchVendors represents a vendor search.
+type SearchVendors struct {
+	Filter *SearchVendorsFilter `json:"filter,omitempty"`
+	Sort   *SearchVendorsSort   `json:"sort,omitempty"`
+	Cursor string               `json:"cursor,omitempty"`
+}
+
+// SearchVendorsFilter represents the criteria of a vendor search. A vendor matches if it has one of the names and
+// one of the statuses; unset criteria are ignored.
+type SearchVendorsFilter struct {
+	Name   []string `json:"name,omitempty"`
+	Status []string `json:"status,omitempty"`
+}
+
+// SearchVendorsSort represents the order of the results of a vendor search.
+type SearchVendorsSort struct {
+	Field string `json:"field,omitempty"`
+	Order string `json:"order,omitempty"`
+}
+
+// BulkCreateVendors creates several vendors at once, keyed by a client generated key. Each vendor succeeds or
+// fails independently.
+func (s *VendorServiceOp) BulkCreateVendors(ctx context.Context, vendors map[string]VendorEntry) (*BulkVendors, *Response, error) {
+	if len(vendors) == 0 {
+		return nil, nil, NewArgError("vendors", "cannot be empty")
+	}
+
+	body := struct {
+		Vendors map[string]VendorEntry `json:"vendors"`
+	}{Vendors: vendors}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(VendorBasePath, vendorBulkCreatePath), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BulkVendors)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// BulkRetrieveVendors returns several vendors by ID. The result is keyed by vendor ID.
+func (s *VendorServiceOp) BulkRetrieveVendors(ctx context.Context, vendorIds []string) (*BulkVendors, *Response, error) {
+	if len(vendorIds) == 0 {
+		return nil, nil, NewArgError("vendorIds", "cannot be empty")
+	}
+
+	body := struct {
+		VendorIds []string `json:"vendor_ids"`
+	}{VendorIds: vendorIds}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(VendorBasePath, vendorBulkRetrievePath), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BulkVendors)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// BulkUpdateVendors updates several vendors at once, keyed by vendor ID. Each vendor succeeds or fails
+// independently.
+func (s *VendorServiceOp) BulkUpdateVendors(ctx context.Context, vendors map[string]UpdateVendor) (*BulkVendors, *Response, error) {
+	if len(vendors) == 0 {
+		return nil, nil, NewArgError("vendors", "cannot be empty")
+	}
+
+	body := struct {
+		Vendors map[string]UpdateVendor `json:"vendors"`
+	}{Vendors: vendors}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(VendorBasePath, vendorBulkUpdatePath), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(BulkVendors)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateVendor creates a vendor.
+func (s *VendorServiceOp) CreateVendor(ctx context.Context, vendor *CreateVendor) (*Vendor, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(VendorBasePath, vendorCreatePath), vendor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Vendor)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveVendor returns a vendor by ID.
+func (s *VendorServiceOp) RetrieveVendor(ctx context.Context, vendorId string) (*Vendor, *Response, error) {
+	if len(vendorId) == 0 {
+		return nil, nil, NewArgError("vendorId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeVendor, vendorId)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(VendorBasePath, vendorId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Vendor)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateVendor updates a vendor.
+func (s *VendorServiceOp) UpdateVendor(ctx context.Context, vendorId string, vendor *UpdateVendor) (*Vendor, *Response, error) {
+	if len(vendorId) == 0 {
+		return nil, nil, NewArgError("vendorId", "cannot be an empty string")
+	}
+
+	ctx = withCache(ctx, cacheTypeVendor, vendorId)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(VendorBasePath, vendorId), vendor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(Vendor)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// SearchVendors searches vendors by name and status.
+func (s *VendorServiceOp) SearchVendors(ctx context.Context, search *SearchVendors) (*ListVendors, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(VendorBasePath, vendorSearchPath), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListVendors)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,342 @@
+package squareup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	vendorResponse = `
+{
+  "vendor": {
+    "id": "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4",
+    "created_at": "2022-03-16T10:21:54.859Z",
+    "updated_at": "2022-03-16T10:21:54.859Z",
+    "name": "Joe's Fresh Seafood",
+    "contacts": [
+      {
+        "id": "INV_VC_ABYYHBWT1D4F8MFH63DBMEN8Y4",
+        "name": "Joe Burrow",
+        "email_address": "joe@joesfreshseafood.com",
+        "phone_number": "1-212-555-4250",
+        "ordinal": 0
+      }
+    ],
+    "account_number": "4025391",
+    "version": 1,
+    "status": "ACTIVE"
+  }
+}`
+
+	bulkVendorsResponse = `
+{
+  "responses": {
+    "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4": {
+      "vendor": {
+        "id": "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4",
+        "name": "Joe's Fresh Seafood",
+        "version": 2,
+        "status": "ACTIVE"
+      }
+    },
+    "INV_V_MISSING": {
+      "errors": [
+        {
+          "category": "INVALID_REQUEST_ERROR",
+          "code": "NOT_FOUND",
+          "detail": "Vendor not found"
+        }
+      ]
+    }
+  }
+}`
+)
+
+func expectedVendor() *Vendor {
+	createdAt := time.Date(2022, 3, 16, 10, 21, 54, 859000000, time.UTC)
+	return &Vendor{
+		Vendor: &VendorEntry{
+			Id:        "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4",
+			CreatedAt: &createdAt,
+			UpdatedAt: &createdAt,
+			Name:      "Joe's Fresh Seafood",
+			Contacts: []VendorContact{
+				{
+					Id:           "INV_VC_ABYYHBWT1D4F8MFH63DBMEN8Y4",
+					Name:         "Joe Burrow",
+					EmailAddress: "joe@joesfreshseafood.com",
+					PhoneNumber:  "1-212-555-4250",
+				},
+			},
+			AccountNumber: "4025391",
+			Version:       1,
+			Status:        VendorStatusActive,
+		},
+	}
+}
+
+func expectedBulkVendors() *BulkVendors {
+	return &BulkVendors{
+		Responses: map[string]BulkVendorResult{
+			"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4": {
+				Vendor: &VendorEntry{
+					Id:      "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4",
+					Name:    "Joe's Fresh Seafood",
+					Version: 2,
+					Status:  VendorStatusActive,
+				},
+			},
+			"INV_V_MISSING": {
+				Errors: []APIError{{Category: "INVALID_REQUEST_ERROR", Code: "NOT_FOUND", Detail: "Vendor not found"}},
+			},
+		},
+	}
+}
+
+func TestVendorServiceOp_BulkCreateVendors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	vendors := map[string]VendorEntry{
+		"8fc6a5b0-9fe8-4b46-b46b-2ef95793abbe": {
+			Name:     "Joe's Fresh Seafood",
+			Contacts: []VendorContact{{Name: "Joe Burrow", Ordinal: 0}},
+			Status:   VendorStatusActive,
+		},
+	}
+
+	mux.HandleFunc("/v2/vendors/bulk-create", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var v struct {
+			Vendors map[string]VendorEntry `json:"vendors"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v.Vendors, vendors) {
+			t.Errorf("Request body = %+v, expected %+v", v.Vendors, vendors)
+		}
+
+		fmt.Fprint(w, bulkVendorsResponse)
+	})
+
+	got, _, err := client.Vendor.BulkCreateVendors(ctx, vendors)
+	if err != nil {
+		t.Fatalf("Vendor.BulkCreateVendors returned error: %v", err)
+	}
+	if expected := expectedBulkVendors(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.BulkCreateVendors returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Vendor.BulkCreateVendors(ctx, nil); err == nil {
+		t.Error("Vendor.BulkCreateVendors without vendors returned no error")
+	}
+}
+
+func TestVendorServiceOp_BulkRetrieveVendors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/vendors/bulk-retrieve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		expected := `{"vendor_ids":["INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4","INV_V_MISSING"]}`
+		if got := strings.TrimSpace(StreamToString(r.Body)); got != expected {
+			t.Errorf("Request body = %s, expected %s", got, expected)
+		}
+		fmt.Fprint(w, bulkVendorsResponse)
+	})
+
+	got, _, err := client.Vendor.BulkRetrieveVendors(ctx, []string{"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", "INV_V_MISSING"})
+	if err != nil {
+		t.Fatalf("Vendor.BulkRetrieveVendors returned error: %v", err)
+	}
+	if expected := expectedBulkVendors(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.BulkRetrieveVendors returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Vendor.BulkRetrieveVendors(ctx, nil); err == nil {
+		t.Error("Vendor.BulkRetrieveVendors without IDs returned no error")
+	}
+}
+
+func TestVendorServiceOp_BulkUpdateVendors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	vendors := map[string]UpdateVendor{
+		"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4": {
+			IdempotencyKey: "8fc6a5b0-9fe8-4b46-b46b-2ef95793abbe",
+			Vendor:         &VendorEntry{Id: "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", Version: 1, Status: VendorStatusActive},
+		},
+	}
+
+	mux.HandleFunc("/v2/vendors/bulk-update", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var v struct {
+			Vendors map[string]UpdateVendor `json:"vendors"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v.Vendors, vendors) {
+			t.Errorf("Request body = %+v, expected %+v", v.Vendors, vendors)
+		}
+
+		fmt.Fprint(w, bulkVendorsResponse)
+	})
+
+	got, _, err := client.Vendor.BulkUpdateVendors(ctx, vendors)
+	if err != nil {
+		t.Fatalf("Vendor.BulkUpdateVendors returned error: %v", err)
+	}
+	if expected := expectedBulkVendors(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.BulkUpdateVendors returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Vendor.BulkUpdateVendors(ctx, nil); err == nil {
+		t.Error("Vendor.BulkUpdateVendors without vendors returned no error")
+	}
+}
+
+func TestVendorServiceOp_CreateVendor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	vendor := &CreateVendor{
+		IdempotencyKey: "8fc6a5b0-9fe8-4b46-b46b-2ef95793abbe",
+		Vendor: &VendorEntry{
+			Name:          "Joe's Fresh Seafood",
+			Contacts:      []VendorContact{{Name: "Joe Burrow", EmailAddress: "joe@joesfreshseafood.com", PhoneNumber: "1-212-555-4250"}},
+			AccountNumber: "4025391",
+			Status:        VendorStatusActive,
+		},
+	}
+
+	mux.HandleFunc("/v2/vendors/create", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(CreateVendor)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, vendor) {
+			t.Errorf("Request body = %+v, expected %+v", v, vendor)
+		}
+
+		fmt.Fprint(w, vendorResponse)
+	})
+
+	got, _, err := client.Vendor.CreateVendor(ctx, vendor)
+	if err != nil {
+		t.Fatalf("Vendor.CreateVendor returned error: %v", err)
+	}
+	if expected := expectedVendor(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.CreateVendor returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestVendorServiceOp_RetrieveVendor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, vendorResponse)
+	})
+
+	got, _, err := client.Vendor.RetrieveVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4")
+	if err != nil {
+		t.Fatalf("Vendor.RetrieveVendor returned error: %v", err)
+	}
+	if expected := expectedVendor(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.RetrieveVendor returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Vendor.RetrieveVendor(ctx, ""); err == nil {
+		t.Error("Vendor.RetrieveVendor with an empty ID returned no error")
+	}
+}
+
+func TestVendorServiceOp_UpdateVendor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	vendor := &UpdateVendor{
+		IdempotencyKey: "8fc6a5b0-9fe8-4b46-b46b-2ef95793abbe",
+		Vendor: &VendorEntry{
+			Id:       "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4",
+			Version:  1,
+			Contacts: []VendorContact{{Id: "INV_VC_ABYYHBWT1D4F8MFH63DBMEN8Y4", Removed: true}},
+		},
+	}
+
+	mux.HandleFunc("/v2/vendors/INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		v := new(UpdateVendor)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, vendor) {
+			t.Errorf("Request body = %+v, expected %+v", v, vendor)
+		}
+
+		fmt.Fprint(w, vendorResponse)
+	})
+
+	got, _, err := client.Vendor.UpdateVendor(ctx, "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", vendor)
+	if err != nil {
+		t.Fatalf("Vendor.UpdateVendor returned error: %v", err)
+	}
+	if expected := expectedVendor(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.UpdateVendor returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Vendor.UpdateVendor(ctx, "", vendor); err == nil {
+		t.Error("Vendor.UpdateVendor with an empty ID returned no error")
+	}
+}
+
+func TestVendorServiceOp_SearchVendors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	search := &SearchVendors{
+		Filter: &SearchVendorsFilter{Name: []string{"Joe's Fresh Seafood"}, Status: []string{VendorStatusActive}},
+		Sort:   &SearchVendorsSort{Field: "NAME", Order: "ASC"},
+	}
+
+	mux.HandleFunc("/v2/vendors/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		v := new(SearchVendors)
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(v, search) {
+			t.Errorf("Request body = %+v, expected %+v", v, search)
+		}
+
+		fmt.Fprint(w, `{"vendors":[{"id":"INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4","name":"Joe's Fresh Seafood","status":"ACTIVE"}],"cursor":"NEXT"}`)
+	})
+
+	got, _, err := client.Vendor.SearchVendors(ctx, search)
+	if err != nil {
+		t.Fatalf("Vendor.SearchVendors returned error: %v", err)
+	}
+
+	expected := &ListVendors{
+		Vendors: []VendorEntry{{Id: "INV_V_JDKYHBWT1D4F8MFH63DBMEN8Y4", Name: "Joe's Fresh Seafood", Status: VendorStatusActive}},
+		Cursor:  "NEXT",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vendor.SearchVendors returned %+v, expected %+v", got, expected)
+	}
+}
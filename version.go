@@ -0,0 +1,56 @@
+package squareup
+
+import (
+	"context"
+	"errors"
+)
+
+// DefaultVersionRetryAttempts is the number of attempts WithVersionRetry makes when given no limit.
+const DefaultVersionRetryAttempts = 3
+
+// ErrVersionMismatch matches, with errors.Is, the API errors returned when the version token of a request is not
+// the current version of the resource, because it changed since it was read.
+var ErrVersionMismatch = errors.New("squareup: version mismatch")
+
+// WithVersionRetry performs an update protected by a version token: it reads the latest version of a resource
+// with read, then passes it to submit, which applies the change of the caller and sends it with the version token
+// of the resource. Both are called again as long as submit fails with ErrVersionMismatch, up to maxAttempts times
+// (DefaultVersionRetryAttempts if maxAttempts is not positive). The last error is returned once attempts run out.
+//
+// For example, to complete the latest version of a payment:
+//
+//	payment, _, err := squareup.WithVersionRetry(ctx, 0,
+//		func(ctx context.Context) (*squareup.Payment, *squareup.Response, error) {
+//			return client.Payment.GetPayment(ctx, paymentId)
+//		},
+//		func(ctx context.Context, p *squareup.Payment) (*squareup.Payment, *squareup.Response, error) {
+//			return client.Payment.CompletePayment(ctx, paymentId, p.Payment.VersionToken)
+//		})
+func WithVersionRetry[T any](ctx context.Context, maxAttempts int, read func(context.Context) (T, *Response, error), submit func(context.Context, T) (T, *Response, error)) (T, *Response, error) {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultVersionRetryAttempts
+	}
+
+	var (
+		result T
+		resp   *Response
+		err    error
+	)
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		var latest T
+		latest, resp, err = read(ctx)
+		if err != nil {
+			return result, resp, err
+		}
+
+		result, resp, err = submit(ctx, latest)
+		if !errors.Is(err, ErrVersionMismatch) {
+			return result, resp, err
+		}
+		if err := ctx.Err(); err != nil {
+			return result, resp, err
+		}
+	}
+
+	return result, resp, err
+}
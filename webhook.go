@@ -0,0 +1,139 @@
+package squareup
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WebhookSignatureHeader is the header Square signs webhook notifications in.
+const WebhookSignatureHeader = "x-square-hmacsha256-signature"
+
+// MaxWebhookBodySize is the size, in bytes, of the largest webhook notification ParseEvent reads.
+const MaxWebhookBodySize = 1 << 20
+
+// ErrInvalidSignature is returned when a webhook notification is not signed with the signature key of the
+// verifier.
+var ErrInvalidSignature = errors.New("squareup: invalid webhook signature")
+
+// WebhookVerifier verifies that webhook notifications were sent by Square. The signature key can be replaced
+// while the verifier is in use, so a rotated key takes effect without a restart. It is safe for concurrent use.
+type WebhookVerifier struct {
+	notificationURL string
+
+	now func() time.Time
+
+	mu                sync.RWMutex
+	key               string
+	previousKey       string
+	previousExpiresAt time.Time
+}
+
+// NewWebhookVerifier creates a verifier for notifications sent to notificationURL, which must be the URL of the
+// webhook subscription exactly as registered.
+func NewWebhookVerifier(notificationURL, signatureKey string) (*WebhookVerifier, error) {
+	if len(notificationURL) == 0 {
+		return nil, NewArgError("notificationURL", "cannot be an empty string")
+	}
+	if len(signatureKey) == 0 {
+		return nil, NewArgError("signatureKey", "cannot be an empty string")
+	}
+
+	return &WebhookVerifier{notificationURL: notificationURL, key: signatureKey, now: time.Now}, nil
+}
+
+// SetSignatureKey replaces the signature key. The replaced key is still accepted for grace, so notifications
+// signed before the rotation and delivered after it are not rejected. A grace of zero rejects it at once.
+func (v *WebhookVerifier) SetSignatureKey(signatureKey string, grace time.Duration) error {
+	if len(signatureKey) == 0 {
+		return NewArgError("signatureKey", "cannot be an empty string")
+	}
+	if grace < 0 {
+		return NewArgError("grace", "cannot be negative")
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if signatureKey != v.key {
+		v.previousKey = v.key
+		v.previousExpiresAt = v.now().Add(grace)
+		v.key = signatureKey
+	}
+	return nil
+}
+
+// DropPreviousKey stops accepting the key replaced by the last SetSignatureKey before its grace period ends.
+func (v *WebhookVerifier) DropPreviousKey() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.previousKey = ""
+	v.previousExpiresAt = time.Time{}
+}
+
+// RotateSignatureKey replaces the signature key of the webhook subscription and loads the new key into the
+// verifier. The replaced key is still accepted for grace.
+func (v *WebhookVerifier) RotateSignatureKey(ctx context.Context, s WebhookSubscriptionService, subscriptionId, idempotencyKey string, grace time.Duration) (*Response, error) {
+	key, resp, err := s.UpdateWebhookSubscriptionSignatureKey(ctx, subscriptionId, idempotencyKey)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, v.SetSignatureKey(key.SignatureKey, grace)
+}
+
+// Verify returns ErrInvalidSignature unless signature is a valid signature of body.
+func (v *WebhookVerifier) Verify(body []byte, signature string) error {
+	v.mu.RLock()
+	key, previousKey, previousExpiresAt := v.key, v.previousKey, v.previousExpiresAt
+	v.mu.RUnlock()
+
+	if v.valid(key, body, signature) {
+		return nil
+	}
+	if len(previousKey) > 0 && v.now().Before(previousExpiresAt) && v.valid(previousKey, body, signature) {
+		return nil
+	}
+	return ErrInvalidSignature
+}
+
+// ParseEvent reads and verifies the webhook notification of r and returns its event. Notifications larger than
+// MaxWebhookBodySize are rejected.
+func (v *WebhookVerifier) ParseEvent(r *http.Request) (*Event, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxWebhookBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > MaxWebhookBodySize {
+		return nil, NewArgError("body", fmt.Sprintf("cannot be larger than %d bytes", MaxWebhookBodySize))
+	}
+
+	if err := v.Verify(body, r.Header.Get(WebhookSignatureHeader)); err != nil {
+		return nil, err
+	}
+
+	e := new(Event)
+	if err := json.Unmarshal(body, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// valid reports whether signature is the signature of the notification URL followed by body under key.
+func (v *WebhookVerifier) valid(key string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(v.notificationURL))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
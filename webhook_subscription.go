@@ -0,0 +1,267 @@
+package squareup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	WebhookBasePath = "v2/webhooks"
+
+	webhookEventTypesPath    = "event-types"
+	webhookSubscriptionsPath = "subscriptions"
+	webhookSignatureKeyPath  = "signature-key"
+	webhookTestPath          = "test"
+)
+
+// WebhookSubscriptionService is an interface for interfacing with the Square Webhook Subscriptions API.
+type WebhookSubscriptionService interface {
+	ListWebhookEventTypes(ctx context.Context, apiVersion string) (*ListWebhookEventTypes, *Response, error)
+	ListWebhookSubscriptions(ctx context.Context, options *ListOptions) (*ListWebhookSubscriptions, *Response, error)
+	CreateWebhookSubscription(ctx context.Context, subscription *CreateWebhookSubscription) (*WebhookSubscription, *Response, error)
+	RetrieveWebhookSubscription(ctx context.Context, subscriptionId string) (*WebhookSubscription, *Response, error)
+	UpdateWebhookSubscription(ctx context.Context, subscriptionId string, subscription *WebhookSubscriptionEntry) (*WebhookSubscription, *Response, error)
+	DeleteWebhookSubscription(ctx context.Context, subscriptionId string) (*Response, error)
+	UpdateWebhookSubscriptionSignatureKey(ctx context.Context, subscriptionId, idempotencyKey string) (*WebhookSignatureKey, *Response, error)
+	TestWebhookSubscription(ctx context.Context, subscriptionId string, eventType EventType) (*WebhookSubscriptionTest, *Response, error)
+}
+
+var _ WebhookSubscriptionService = &WebhookSubscriptionServiceOp{}
+
+// WebhookSubscriptionServiceOp handles communication with the webhook subscription related methods of the
+// Square API.
+type WebhookSubscriptionServiceOp struct {
+	client *Client
+}
+
+// ListWebhookEventTypes represents the event types webhooks can be subscribed to.
+type ListWebhookEventTypes struct {
+	EventTypes []EventType                `json:"event_types"`
+	Metadata   []WebhookEventTypeMetadata `json:"metadata,omitempty"`
+}
+
+// WebhookEventTypeMetadata represents the release information of an event type.
+type WebhookEventTypeMetadata struct {
+	EventType            EventType `json:"event_type"`
+	ApiVersionIntroduced string    `json:"api_version_introduced,omitempty"`
+	ReleaseStatus        string    `json:"release_status,omitempty"`
+}
+
+// ListWebhookSubscriptions represents a list of webhook subscriptions.
+type ListWebhookSubscriptions struct {
+	Subscriptions []WebhookSubscriptionEntry `json:"subscriptions"`
+	Cursor        string                     `json:"cursor,omitempty"`
+}
+
+// WebhookSubscription represents a webhook subscription.
+type WebhookSubscription struct {
+	Subscription *WebhookSubscriptionEntry `json:"subscription"`
+}
+
+// WebhookSubscriptionEntry represents the event types sent to a notification URL. SignatureKey is only returned
+// when the subscription is created.
+type WebhookSubscriptionEntry struct {
+	Id              string      `json:"id,omitempty"`
+	Name            string      `json:"name,omitempty"`
+	Enabled         *bool       `json:"enabled,omitempty"`
+	EventTypes      []EventType `json:"event_types,omitempty"`
+	NotificationUrl string      `json:"notification_url,omitempty"`
+	ApiVersion      string      `json:"api_version,omitempty"`
+	SignatureKey    string      `json:"signature_key,omitempty"`
+	CreatedAt       *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time  `json:"updated_at,omitempty"`
+}
+
+// CreateWebhookSubscription represents a webhook subscription to be created.
+type CreateWebhookSubscription struct {
+	IdempotencyKey string                    `json:"idempotency_key,omitempty"`
+	Subscription   *WebhookSubscriptionEntry `json:"subscription"`
+}
+
+// WebhookSignatureKey represents the new signature key of a webhook subscription.
+type WebhookSignatureKey struct {
+	SignatureKey string `json:"signature_key"`
+}
+
+// WebhookSubscriptionTest represents the result of sending a test event to a webhook subscription.
+type WebhookSubscriptionTest struct {
+	SubscriptionTestResult *WebhookSubscriptionTestResult `json:"subscription_test_result"`
+}
+
+// WebhookSubscriptionTestResult represents the response of the notification URL to a test event.
+type WebhookSubscriptionTestResult struct {
+	Id         string     `json:"id"`
+	StatusCode int        `json:"status_code"`
+	Payload    string     `json:"payload"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListWebhookEventTypes returns the event types webhooks can be subscribed to. If apiVersion is empty, the event
+// types of the version of the application are returned.
+func (s *WebhookSubscriptionServiceOp) ListWebhookEventTypes(ctx context.Context, apiVersion string) (*ListWebhookEventTypes, *Response, error) {
+	p, err := addOptions(path.Join(WebhookBasePath, webhookEventTypesPath), &struct {
+		ApiVersion string `url:"api_version,omitempty"`
+	}{ApiVersion: apiVersion})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListWebhookEventTypes)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ListWebhookSubscriptions returns a list of the webhook subscriptions of the application.
+func (s *WebhookSubscriptionServiceOp) ListWebhookSubscriptions(ctx context.Context, options *ListOptions) (*ListWebhookSubscriptions, *Response, error) {
+	p, err := addOptions(path.Join(WebhookBasePath, webhookSubscriptionsPath), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, p, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(ListWebhookSubscriptions)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// CreateWebhookSubscription creates a webhook subscription. The returned subscription holds the signature key
+// notifications are signed with.
+func (s *WebhookSubscriptionServiceOp) CreateWebhookSubscription(ctx context.Context, subscription *CreateWebhookSubscription) (*WebhookSubscription, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(WebhookBasePath, webhookSubscriptionsPath), subscription)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WebhookSubscription)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RetrieveWebhookSubscription returns a webhook subscription by ID.
+func (s *WebhookSubscriptionServiceOp) RetrieveWebhookSubscription(ctx context.Context, subscriptionId string) (*WebhookSubscription, *Response, error) {
+	if len(subscriptionId) == 0 {
+		return nil, nil, NewArgError("subscriptionId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path.Join(WebhookBasePath, webhookSubscriptionsPath, subscriptionId), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WebhookSubscription)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// UpdateWebhookSubscription updates a webhook subscription.
+func (s *WebhookSubscriptionServiceOp) UpdateWebhookSubscription(ctx context.Context, subscriptionId string, subscription *WebhookSubscriptionEntry) (*WebhookSubscription, *Response, error) {
+	if len(subscriptionId) == 0 {
+		return nil, nil, NewArgError("subscriptionId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path.Join(WebhookBasePath, webhookSubscriptionsPath, subscriptionId), &WebhookSubscription{Subscription: subscription})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WebhookSubscription)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// DeleteWebhookSubscription deletes a webhook subscription.
+func (s *WebhookSubscriptionServiceOp) DeleteWebhookSubscription(ctx context.Context, subscriptionId string) (*Response, error) {
+	if len(subscriptionId) == 0 {
+		return nil, NewArgError("subscriptionId", "cannot be an empty string")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path.Join(WebhookBasePath, webhookSubscriptionsPath, subscriptionId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UpdateWebhookSubscriptionSignatureKey replaces the signature key of a webhook subscription. Notifications are
+// signed with the new key right away, so it should be handed to the WebhookVerifier without delay; see
+// WebhookVerifier.RotateSignatureKey.
+func (s *WebhookSubscriptionServiceOp) UpdateWebhookSubscriptionSignatureKey(ctx context.Context, subscriptionId, idempotencyKey string) (*WebhookSignatureKey, *Response, error) {
+	if len(subscriptionId) == 0 {
+		return nil, nil, NewArgError("subscriptionId", "cannot be an empty string")
+	}
+
+	body := struct {
+		IdempotencyKey string `json:"idempotency_key,omitempty"`
+	}{IdempotencyKey: idempotencyKey}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(WebhookBasePath, webhookSubscriptionsPath, subscriptionId, webhookSignatureKeyPath), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WebhookSignatureKey)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// TestWebhookSubscription sends a test event of the given type to the notification URL of a webhook
+// subscription.
+func (s *WebhookSubscriptionServiceOp) TestWebhookSubscription(ctx context.Context, subscriptionId string, eventType EventType) (*WebhookSubscriptionTest, *Response, error) {
+	if len(subscriptionId) == 0 {
+		return nil, nil, NewArgError("subscriptionId", "cannot be an empty string")
+	}
+
+	body := struct {
+		EventType EventType `json:"event_type,omitempty"`
+	}{EventType: eventType}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path.Join(WebhookBasePath, webhookSubscriptionsPath, subscriptionId, webhookTestPath), &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(WebhookSubscriptionTest)
+	resp, err := s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
@@ -0,0 +1,222 @@
+package squareup
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var webhookSubscriptionResponse = `
+{
+  "subscription": {
+    "id": "wbhk_b35f6b3145074cf9ad513610786c19d5",
+    "name": "Example Webhook Subscription",
+    "enabled": true,
+    "event_types": ["payment.created", "payment.updated"],
+    "notification_url": "https://example-webhook-url.com",
+    "api_version": "2021-12-15",
+    "signature_key": "1k9bIJKCeTmSQwyagtNRLg"
+  }
+}`
+
+func expectedWebhookSubscription() *WebhookSubscription {
+	return &WebhookSubscription{Subscription: &WebhookSubscriptionEntry{
+		Id:              "wbhk_b35f6b3145074cf9ad513610786c19d5",
+		Name:            "Example Webhook Subscription",
+		Enabled:         PtrTo(true),
+		EventTypes:      []EventType{"payment.created", "payment.updated"},
+		NotificationUrl: "https://example-webhook-url.com",
+		ApiVersion:      "2021-12-15",
+		SignatureKey:    "1k9bIJKCeTmSQwyagtNRLg",
+	}}
+}
+
+func TestWebhookSubscriptionServiceOp_ListWebhookEventTypes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/event-types", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"api_version": "2021-12-15"})
+		fmt.Fprint(w, `{
+  "event_types": ["inventory.count.updated"],
+  "metadata": [{"event_type": "inventory.count.updated", "api_version_introduced": "2018-07-12", "release_status": "PUBLIC"}]
+}`)
+	})
+
+	got, _, err := client.Webhook.ListWebhookEventTypes(ctx, "2021-12-15")
+	if err != nil {
+		t.Fatalf("Webhook.ListWebhookEventTypes returned error: %v", err)
+	}
+
+	expected := &ListWebhookEventTypes{
+		EventTypes: []EventType{"inventory.count.updated"},
+		Metadata: []WebhookEventTypeMetadata{{
+			EventType:            "inventory.count.updated",
+			ApiVersionIntroduced: "2018-07-12",
+			ReleaseStatus:        "PUBLIC",
+		}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Webhook.ListWebhookEventTypes returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestWebhookSubscriptionServiceOp_ListWebhookSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testFormValues(t, r, values{"cursor": "cursor-1"})
+		fmt.Fprint(w, `{"subscriptions":[{"id":"wbhk_b35f6b3145074cf9ad513610786c19d5","enabled":true}],"cursor":"cursor-2"}`)
+	})
+
+	got, _, err := client.Webhook.ListWebhookSubscriptions(ctx, &ListOptions{Cursor: "cursor-1"})
+	if err != nil {
+		t.Fatalf("Webhook.ListWebhookSubscriptions returned error: %v", err)
+	}
+
+	expected := &ListWebhookSubscriptions{
+		Subscriptions: []WebhookSubscriptionEntry{{Id: "wbhk_b35f6b3145074cf9ad513610786c19d5", Enabled: PtrTo(true)}},
+		Cursor:        "cursor-2",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Webhook.ListWebhookSubscriptions returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestWebhookSubscriptionServiceOp_CreateWebhookSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	create := &CreateWebhookSubscription{
+		IdempotencyKey: "63f84c6c-2200-4c99-846c-2670a1311fbf",
+		Subscription: &WebhookSubscriptionEntry{
+			Name:            "Example Webhook Subscription",
+			EventTypes:      []EventType{"payment.created", "payment.updated"},
+			NotificationUrl: "https://example-webhook-url.com",
+			ApiVersion:      "2021-12-15",
+		},
+	}
+
+	mux.HandleFunc("/v2/webhooks/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, new(CreateWebhookSubscription), create)
+		fmt.Fprint(w, webhookSubscriptionResponse)
+	})
+
+	got, _, err := client.Webhook.CreateWebhookSubscription(ctx, create)
+	if err != nil {
+		t.Fatalf("Webhook.CreateWebhookSubscription returned error: %v", err)
+	}
+	if expected := expectedWebhookSubscription(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Webhook.CreateWebhookSubscription returned %+v, expected %+v", got, expected)
+	}
+}
+
+func TestWebhookSubscriptionServiceOp_RetrieveWebhookSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/subscriptions/wbhk_b35f6b3145074cf9ad513610786c19d5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, webhookSubscriptionResponse)
+	})
+
+	got, _, err := client.Webhook.RetrieveWebhookSubscription(ctx, "wbhk_b35f6b3145074cf9ad513610786c19d5")
+	if err != nil {
+		t.Fatalf("Webhook.RetrieveWebhookSubscription returned error: %v", err)
+	}
+	if expected := expectedWebhookSubscription(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Webhook.RetrieveWebhookSubscription returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Webhook.RetrieveWebhookSubscription(ctx, ""); err == nil {
+		t.Error("Webhook.RetrieveWebhookSubscription with an empty ID returned no error")
+	}
+}
+
+func TestWebhookSubscriptionServiceOp_UpdateWebhookSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	subscription := &WebhookSubscriptionEntry{Name: "Updated Example Webhook Subscription", Enabled: PtrTo(false)}
+
+	mux.HandleFunc("/v2/webhooks/subscriptions/wbhk_b35f6b3145074cf9ad513610786c19d5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testJSONBody(t, r, new(WebhookSubscription), &WebhookSubscription{Subscription: subscription})
+		fmt.Fprint(w, webhookSubscriptionResponse)
+	})
+
+	got, _, err := client.Webhook.UpdateWebhookSubscription(ctx, "wbhk_b35f6b3145074cf9ad513610786c19d5", subscription)
+	if err != nil {
+		t.Fatalf("Webhook.UpdateWebhookSubscription returned error: %v", err)
+	}
+	if expected := expectedWebhookSubscription(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Webhook.UpdateWebhookSubscription returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Webhook.UpdateWebhookSubscription(ctx, "", subscription); err == nil {
+		t.Error("Webhook.UpdateWebhookSubscription with an empty ID returned no error")
+	}
+}
+
+func TestWebhookSubscriptionServiceOp_DeleteWebhookSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var deleted bool
+	mux.HandleFunc("/v2/webhooks/subscriptions/wbhk_b35f6b3145074cf9ad513610786c19d5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = true
+		fmt.Fprint(w, `{}`)
+	})
+
+	if _, err := client.Webhook.DeleteWebhookSubscription(ctx, "wbhk_b35f6b3145074cf9ad513610786c19d5"); err != nil {
+		t.Fatalf("Webhook.DeleteWebhookSubscription returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("Webhook.DeleteWebhookSubscription sent no request")
+	}
+
+	if _, err := client.Webhook.DeleteWebhookSubscription(ctx, ""); err == nil {
+		t.Error("Webhook.DeleteWebhookSubscription with an empty ID returned no error")
+	}
+}
+
+func TestWebhookSubscriptionServiceOp_TestWebhookSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/subscriptions/wbhk_b35f6b3145074cf9ad513610786c19d5/test", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRawBody(t, r, `{"event_type":"payment.created"}`)
+		fmt.Fprint(w, `{
+  "subscription_test_result": {
+    "id": "23eed5a9-2b12-403e-b212-7e2889aea0f6",
+    "status_code": 404,
+    "payload": "{\"merchant_id\":\"1ZYMKZY1YFGBW\",\"type\":\"payment.created\"}"
+  }
+}`)
+	})
+
+	got, _, err := client.Webhook.TestWebhookSubscription(ctx, "wbhk_b35f6b3145074cf9ad513610786c19d5", "payment.created")
+	if err != nil {
+		t.Fatalf("Webhook.TestWebhookSubscription returned error: %v", err)
+	}
+
+	expected := &WebhookSubscriptionTest{SubscriptionTestResult: &WebhookSubscriptionTestResult{
+		Id:         "23eed5a9-2b12-403e-b212-7e2889aea0f6",
+		StatusCode: http.StatusNotFound,
+		Payload:    `{"merchant_id":"1ZYMKZY1YFGBW","type":"payment.created"}`,
+	}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Webhook.TestWebhookSubscription returned %+v, expected %+v", got, expected)
+	}
+
+	if _, _, err := client.Webhook.TestWebhookSubscription(ctx, "", "payment.created"); err == nil {
+		t.Error("Webhook.TestWebhookSubscription with an empty ID returned no error")
+	}
+}
@@ -0,0 +1,133 @@
+package squareup
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const webhookNotificationURL = "https://example.com/webhook"
+
+var (
+	webhookEventBody = `{"merchant_id":"6SSW7HV8K2ST5","type":"payment.created","event_id":"13b867cf-db3d-4b1c-90b6-2f32a9d78124","data":{"type":"payment","id":"KkAkhdMsgzn59SM8A89WgKwekxLZY","object":{"payment":{"id":"KkAkhdMsgzn59SM8A89WgKwekxLZY"}}}}`
+)
+
+func sign(key, body string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(webhookNotificationURL + body))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookVerifier_ParseEvent(t *testing.T) {
+	v, err := NewWebhookVerifier(webhookNotificationURL, "old-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parse := func(signature string) error {
+		r := httptest.NewRequest(http.MethodPost, webhookNotificationURL, bytes.NewBufferString(webhookEventBody))
+		r.Header.Set(WebhookSignatureHeader, signature)
+		_, err := v.ParseEvent(r)
+		return err
+	}
+
+	if err := parse(sign("old-key", webhookEventBody)); err != nil {
+		t.Errorf("ParseEvent returned error: %v", err)
+	}
+	if err := parse(sign("other-key", webhookEventBody)); err != ErrInvalidSignature {
+		t.Errorf("ParseEvent with foreign signature returned %v, expected %v", err, ErrInvalidSignature)
+	}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	v.now = func() time.Time { return now }
+
+	if err := v.SetSignatureKey("new-key", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := parse(sign("new-key", webhookEventBody)); err != nil {
+		t.Errorf("ParseEvent with new key returned error: %v", err)
+	}
+	if err := parse(sign("old-key", webhookEventBody)); err != nil {
+		t.Errorf("ParseEvent with replaced key returned error: %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := parse(sign("old-key", webhookEventBody)); err != ErrInvalidSignature {
+		t.Errorf("ParseEvent with expired key returned %v, expected %v", err, ErrInvalidSignature)
+	}
+	if err := parse(sign("new-key", webhookEventBody)); err != nil {
+		t.Errorf("ParseEvent with new key returned error: %v", err)
+	}
+
+	if err := v.SetSignatureKey("newer-key", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	v.DropPreviousKey()
+	if err := parse(sign("new-key", webhookEventBody)); err != ErrInvalidSignature {
+		t.Errorf("ParseEvent with dropped key returned %v, expected %v", err, ErrInvalidSignature)
+	}
+
+	if err := v.SetSignatureKey("newest-key", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := parse(sign("newer-key", webhookEventBody)); err != ErrInvalidSignature {
+		t.Errorf("ParseEvent with key replaced without grace returned %v, expected %v", err, ErrInvalidSignature)
+	}
+
+	if err := v.SetSignatureKey("", time.Hour); err == nil {
+		t.Error("SetSignatureKey with an empty key returned no error")
+	}
+	if err := v.SetSignatureKey("newest-key", -time.Hour); err == nil {
+		t.Error("SetSignatureKey with a negative grace returned no error")
+	}
+}
+
+func TestWebhookVerifier_ParseEvent_tooLarge(t *testing.T) {
+	v, err := NewWebhookVerifier(webhookNotificationURL, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"type":"payment.created","padding":"` + strings.Repeat("x", MaxWebhookBodySize) + `"}`
+	r := httptest.NewRequest(http.MethodPost, webhookNotificationURL, strings.NewReader(body))
+	r.Header.Set(WebhookSignatureHeader, sign("key", body))
+
+	var argErr *ArgError
+	if _, err := v.ParseEvent(r); !errors.As(err, &argErr) || argErr.Arg() != "body" {
+		t.Errorf("ParseEvent with an oversized body returned %v, expected a body error", err)
+	}
+}
+
+func TestWebhookVerifier_RotateSignatureKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/webhooks/subscriptions/wbhk_b35f6b3145074cf9ad513610786c19d5/signature-key", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"signature_key":"1k9bIJKCeTmSQwyagtNRLg"}`)
+	})
+
+	v, err := NewWebhookVerifier(webhookNotificationURL, "old-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := v.RotateSignatureKey(ctx, client.Webhook, "wbhk_b35f6b3145074cf9ad513610786c19d5", "ed80ae6b-0654-473b-bbab-a39aee89a60d", time.Minute); err != nil {
+		t.Fatalf("RotateSignatureKey returned error: %v", err)
+	}
+
+	if err := v.Verify([]byte(webhookEventBody), sign("1k9bIJKCeTmSQwyagtNRLg", webhookEventBody)); err != nil {
+		t.Errorf("Verify with rotated key returned error: %v", err)
+	}
+	if err := v.Verify([]byte(webhookEventBody), sign("old-key", webhookEventBody)); err != nil {
+		t.Errorf("Verify with replaced key within grace returned error: %v", err)
+	}
+}